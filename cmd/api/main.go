@@ -3,6 +3,8 @@ package main
 import (
 	"log"
 	"os"
+	// The runtime image has no timezone database, the campuses timezones are embedded
+	_ "time/tzdata"
 
 	"github.com/esgi-challenge/backend/config"
 	"github.com/esgi-challenge/backend/internal/server"
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"os"
 	"reflect"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
@@ -21,6 +23,27 @@ type Config struct {
 
 	Bucket    string `env:"BUCKET"`
 	ProjectId string `env:"PROJECT_ID"`
+
+	ClamdAddress string `env:"CLAMD_ADDRESS"`
+
+	// Optional, time before the files of deleted documents are removed from the bucket
+	DocumentGracePeriod time.Duration `env:"DOCUMENT_GRACE_PERIOD"`
+	// Optional, time during which the sender can edit or delete a chat message
+	ChatEditWindow time.Duration `env:"CHAT_EDIT_WINDOW"`
+	// Optional, comma separated origins allowed to open websockets, only the API host when not set
+	WsAllowedOrigins []string `env:"WS_ALLOWED_ORIGINS"`
+	// Optional, push notifications are disabled when the Firebase project is not set
+	FcmProjectId string `env:"FCM_PROJECT_ID" optional:"true"`
+	// Optional, service account JSON file, the application default credentials when not set
+	FcmCredentialsFile string `env:"FCM_CREDENTIALS_FILE" optional:"true"`
+	FcmEndpoint        string `env:"FCM_ENDPOINT" optional:"true"`
+
+	// Base of the invitation and password reset links sent by email
+	EmailLinkBaseUrl string `env:"EMAIL_LINK_BASE_URL"`
+	// Optional, "smtp" by default or "capture" to keep the emails instead of sending them
+	EmailTransport string `env:"EMAIL_TRANSPORT" optional:"true"`
+	// Optional, directory the captured emails are written to, only kept in memory when not set
+	EmailCaptureDir string `env:"EMAIL_CAPTURE_DIR" optional:"true"`
 }
 
 type PostgresConfig struct {
@@ -31,32 +54,34 @@ type PostgresConfig struct {
 	Dbname   string `env:"PG_DBNAME"`
 }
 
+// Only required by the smtp email transport, checked when it is created
 type SMTPConfig struct {
-	Username string `env:"SMTP_USERNAME"`
-	Password string `env:"SMTP_PASSWORD"`
-	Host     string `env:"SMTP_HOST"`
+	Username string `env:"SMTP_USERNAME" optional:"true"`
+	Password string `env:"SMTP_PASSWORD" optional:"true"`
+	Host     string `env:"SMTP_HOST" optional:"true"`
+	// Optional, 587 by default
+	Port string `env:"SMTP_PORT" optional:"true"`
+	// Optional, "starttls" by default, "tls" for implicit TLS or "none"
+	TlsMode string `env:"SMTP_TLS" optional:"true"`
+	// Optional, address the emails are sent from, the username by default
+	Sender string `env:"SMTP_SENDER" optional:"true"`
 }
 
 func hasEmptyFields(v interface{}) bool {
-	val := reflect.ValueOf(v).Elem()
-
-	for i := 0; i < val.NumField(); i++ {
-		field := val.Field(i)
-
-		if isEmptyField(field) {
-			return true
-		}
-	}
-
-	return false
+	return isEmptyField(reflect.ValueOf(v).Elem())
 }
 
+// Fields tagged optional:"true" can be empty
 func isEmptyField(v reflect.Value) bool {
 	switch v.Kind() {
 	case reflect.String:
 		return v.String() == ""
 	case reflect.Struct:
 		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).Tag.Get("optional") == "true" {
+				continue
+			}
+
 			if isEmptyField(v.Field(i)) {
 				return true
 			}
@@ -65,6 +90,38 @@ func isEmptyField(v reflect.Value) bool {
 	return false
 }
 
+// Optional env variable, fallback is used when it's not set
+func getEnv(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+
+	return fallback
+}
+
+// Parse an optional duration env variable like "72h", fallback is used when it's not set
+func getDurationEnv(key string, fallback time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback, nil
+	}
+
+	return time.ParseDuration(value)
+}
+
+// Parse an optional comma separated env variable, empty items are ignored
+func getListEnv(key string) []string {
+	var values []string
+
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
 func LoadConfig(filePath string, env string) (*Config, error) {
 	if env == "LOCAL" {
 		if _, err := os.Stat(filePath); err != nil {
@@ -78,14 +135,16 @@ func LoadConfig(filePath string, env string) (*Config, error) {
 	}
 
 	config := &Config{
-		Port:            os.Getenv("API_PORT"),
-		BaseUrl:         os.Getenv("BASE_URL"),
-		AdminEmail:      os.Getenv("ADMIN_EMAIL"),
-		AdminPassword:   os.Getenv("ADMIN_PASSWORD"),
-		JwtSecret:       os.Getenv("JWT_SECRET"),
-		GoogleMapApiKey: os.Getenv("GMAP_API_KEY"),
-		Bucket:          os.Getenv("BUCKET"),
-		ProjectId:       os.Getenv("PROJECT_ID"),
+		Port:             os.Getenv("API_PORT"),
+		BaseUrl:          os.Getenv("BASE_URL"),
+		AdminEmail:       os.Getenv("ADMIN_EMAIL"),
+		AdminPassword:    os.Getenv("ADMIN_PASSWORD"),
+		JwtSecret:        os.Getenv("JWT_SECRET"),
+		GoogleMapApiKey:  os.Getenv("GMAP_API_KEY"),
+		Bucket:           os.Getenv("BUCKET"),
+		ProjectId:        os.Getenv("PROJECT_ID"),
+		ClamdAddress:     os.Getenv("CLAMD_ADDRESS"),
+		EmailLinkBaseUrl: os.Getenv("EMAIL_LINK_BASE_URL"),
 		Postgres: PostgresConfig{
 			Host:     os.Getenv("PG_HOST"),
 			Port:     os.Getenv("PG_PORT"),
@@ -97,12 +156,39 @@ func LoadConfig(filePath string, env string) (*Config, error) {
 			Username: os.Getenv("SMTP_USERNAME"),
 			Password: os.Getenv("SMTP_PASSWORD"),
 			Host:     os.Getenv("SMTP_HOST"),
+			Port:     getEnv("SMTP_PORT", "587"),
+			TlsMode:  getEnv("SMTP_TLS", "starttls"),
+			Sender:   os.Getenv("SMTP_SENDER"),
 		},
+		EmailTransport:  getEnv("EMAIL_TRANSPORT", "smtp"),
+		EmailCaptureDir: os.Getenv("EMAIL_CAPTURE_DIR"),
+	}
+
+	if config.Smtp.Sender == "" {
+		config.Smtp.Sender = config.Smtp.Username
 	}
 
 	if hasEmptyFields(config) {
 		return nil, errors.New("Some env variables are not set, API won't work without them.")
 	}
 
+	gracePeriod, err := getDurationEnv("DOCUMENT_GRACE_PERIOD", 7*24*time.Hour)
+	if err != nil {
+		return nil, err
+	}
+	config.DocumentGracePeriod = gracePeriod
+
+	editWindow, err := getDurationEnv("CHAT_EDIT_WINDOW", 15*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	config.ChatEditWindow = editWindow
+
+	config.WsAllowedOrigins = getListEnv("WS_ALLOWED_ORIGINS")
+
+	config.FcmProjectId = os.Getenv("FCM_PROJECT_ID")
+	config.FcmCredentialsFile = os.Getenv("FCM_CREDENTIALS_FILE")
+	config.FcmEndpoint = getEnv("FCM_ENDPOINT", "https://fcm.googleapis.com")
+
 	return config, nil
 }
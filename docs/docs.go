@@ -406,66 +406,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/chats/channel": {
+        "/chats/attachments/{id}": {
             "get": {
-                "description": "Get all channels",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Chat"
-                ],
-                "summary": "Get all channels",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Channel"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {}
-                    }
-                }
-            },
-            "post": {
-                "description": "create new channel",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Download an attachment, only for the members of its channel",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
                     "Chat"
                 ],
-                "summary": "Create new channel",
+                "summary": "Download message attachment",
                 "parameters": [
                     {
-                        "description": "Channel infos",
-                        "name": "chat",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ChannelCreate"
-                        }
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Channel"
+                            "type": "file"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -473,16 +451,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/chats/channel/{id}": {
+        "/chats/attachments/{id}/thumbnail": {
             "get": {
-                "description": "Get channel by id",
+                "description": "Get the JPEG thumbnail of an image attachment, only for the members of its channel",
                 "produces": [
-                    "application/json"
+                    "image/jpeg"
                 ],
                 "tags": [
                     "Chat"
                 ],
-                "summary": "Get channel by id",
+                "summary": "Get message attachment thumbnail",
                 "parameters": [
                     {
                         "type": "integer",
@@ -496,13 +474,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Channel"
+                            "type": "file"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {}
@@ -514,23 +496,23 @@ const docTemplate = `{
                 }
             }
         },
-        "/chats/students": {
+        "/chats/blocks": {
             "get": {
-                "description": "Get all possible students chatter",
+                "description": "Get the users blocked by the user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Chat"
                 ],
-                "summary": "Get all possible students chatter",
+                "summary": "Get blocked users",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.UserBlock"
                             }
                         }
                     },
@@ -541,25 +523,68 @@ const docTemplate = `{
                 }
             }
         },
-        "/chats/teachers": {
-            "get": {
-                "description": "Get all possible teacher chatter",
+        "/chats/blocks/{userId}": {
+            "post": {
+                "description": "The blocked user can't open a direct channel with the user nor message it",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Chat"
                 ],
-                "summary": "Get all possible teacher chatter",
+                "summary": "Block user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blocked user id",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
-                            }
-                        }
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "delete": {
+                "description": "Unblock user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chat"
+                ],
+                "summary": "Unblock user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Blocked user id",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -568,23 +593,23 @@ const docTemplate = `{
                 }
             }
         },
-        "/classes": {
+        "/chats/channel": {
             "get": {
-                "description": "Get all class",
+                "description": "Get the direct and group channels the user is a member of, with their last message and unread count",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Class"
+                    "Chat"
                 ],
-                "summary": "Get all class",
+                "summary": "Get all channels",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Channel"
                             }
                         }
                     },
@@ -595,7 +620,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "create new class",
+                "description": "create new channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -603,17 +628,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Class"
+                    "Chat"
                 ],
-                "summary": "Create new class",
+                "summary": "Create new channel",
                 "parameters": [
                     {
-                        "description": "Class infos",
-                        "name": "class",
+                        "description": "Channel infos",
+                        "name": "chat",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ClassCreate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ChannelCreate"
                         }
                     }
                 ],
@@ -621,7 +646,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Channel"
                         }
                     },
                     "400": {
@@ -635,27 +660,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/classes/student": {
+        "/chats/channel/{id}": {
             "get": {
-                "description": "Get class of student",
+                "description": "Get channel by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Class"
+                    "Chat"
+                ],
+                "summary": "Get channel by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get class of user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Channel"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {}
@@ -667,32 +705,62 @@ const docTemplate = `{
                 }
             }
         },
-        "/classes/students/empty": {
-            "get": {
-                "description": "Get school students without class",
+        "/chats/channel/{id}/attachments": {
+            "post": {
+                "description": "Upload a file to the channel, its id is then sent in the attachmentIds of a message. Images get a thumbnail",
+                "consumes": [
+                    "multipart/form-data"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Class"
+                    "Chat"
+                ],
+                "summary": "Upload message attachment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "File",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File name, the uploaded file name by default",
+                        "name": "name",
+                        "in": "formData"
+                    }
                 ],
-                "summary": "Get school students without class",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
-                            }
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageAttachment"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {}
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
                         "schema": {}
                     },
                     "500": {
@@ -702,16 +770,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/classes/{id}": {
+        "/chats/channel/{id}/messages": {
             "get": {
-                "description": "Get class by id",
+                "description": "Get a page of the channel history in chronological order, nextCursor is given as before to load older messages",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Class"
+                    "Chat"
                 ],
-                "summary": "Get class by id",
+                "summary": "Get channel messages",
                 "parameters": [
                     {
                         "type": "integer",
@@ -719,21 +787,33 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Cursor, only messages older than this message id",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default and 100 at most",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessagePage"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {}
                     },
                     "500": {
@@ -741,19 +821,18 @@ const docTemplate = `{
                         "schema": {}
                     }
                 }
-            },
-            "put": {
-                "description": "Update class",
+            }
+        },
+        "/chats/channel/{id}/read": {
+            "post": {
+                "description": "Mark the channel read up to the given message, or up to its latest message without body. A read event is sent to the channel sockets",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Class"
+                    "Chat"
                 ],
-                "summary": "Update class",
+                "summary": "Mark channel as read",
                 "parameters": [
                     {
                         "type": "integer",
@@ -763,41 +842,50 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Class infos",
-                        "name": "class",
+                        "description": "Last read message",
+                        "name": "read",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ClassUpdate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ChannelRead"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete class by id",
+            }
+        },
+        "/chats/messages/{id}": {
+            "put": {
+                "description": "Edit a message sent by the user during the edit window, an edit event is sent to the channel sockets",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Class"
+                    "Chat"
                 ],
-                "summary": "Delete class by id",
+                "summary": "Edit message",
                 "parameters": [
                     {
                         "type": "integer",
@@ -805,36 +893,55 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Message content",
+                        "name": "message",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageUpdate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Message"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
                     }
                 }
-            }
-        },
-        "/classes/{id}/add": {
-            "post": {
-                "description": "Add student to class",
-                "consumes": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Replace a message sent by the user during the edit window by a tombstone, a delete event is sent to the channel sockets",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Class"
+                    "Chat"
                 ],
-                "summary": "Add student to class",
+                "summary": "Delete message",
                 "parameters": [
                     {
                         "type": "integer",
@@ -842,28 +949,76 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Student infos",
-                        "name": "class",
-                        "in": "body",
-                        "required": true,
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ClassAdd"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Message"
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/chats/messages/{id}/history": {
+            "get": {
+                "description": "Get a message with its previous contents, for the administrators of the sender school",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chat"
+                ],
+                "summary": "Get message history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageHistory"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -871,9 +1026,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/classes/{id}/remove": {
-            "delete": {
-                "description": "Remove student from class",
+        "/chats/messages/{id}/report": {
+            "post": {
+                "description": "Report a message of one of the user channels to the school administrators",
                 "consumes": [
                     "application/json"
                 ],
@@ -881,9 +1036,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Class"
+                    "Chat"
                 ],
-                "summary": "Remove student form class",
+                "summary": "Report message",
                 "parameters": [
                     {
                         "type": "integer",
@@ -893,12 +1048,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Student infos",
-                        "name": "class",
+                        "description": "Report reason",
+                        "name": "report",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ClassRemove"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageReportCreate"
                         }
                     }
                 ],
@@ -906,13 +1061,25 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageReport"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -920,23 +1087,23 @@ const docTemplate = `{
                 }
             }
         },
-        "/courses": {
+        "/chats/mutes": {
             "get": {
-                "description": "Get all course",
+                "description": "Get the mutes not over yet of the administrator school",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Course"
+                    "Chat"
                 ],
-                "summary": "Get all course",
+                "summary": "Get chat mutes",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Course"
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ChatMute"
                             }
                         }
                     },
@@ -947,7 +1114,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "create new course",
+                "description": "The muted user can't send, edit or attach anything in chat until the given date",
                 "consumes": [
                     "application/json"
                 ],
@@ -955,17 +1122,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Course"
+                    "Chat"
                 ],
-                "summary": "Create new course",
+                "summary": "Mute user",
                 "parameters": [
                     {
-                        "description": "Course infos",
-                        "name": "course",
+                        "description": "Mute infos",
+                        "name": "mute",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.CourseCreate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ChatMuteCreate"
                         }
                     }
                 ],
@@ -973,13 +1140,21 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Course"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ChatMute"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -987,16 +1162,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/courses/{id}": {
-            "get": {
-                "description": "Get course by id",
+        "/chats/mutes/{id}": {
+            "delete": {
+                "description": "End a mute before its date",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Course"
+                    "Chat"
                 ],
-                "summary": "Get course by id",
+                "summary": "Unmute user",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1008,15 +1183,16 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Course"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {}
@@ -1026,9 +1202,46 @@ const docTemplate = `{
                         "schema": {}
                     }
                 }
-            },
+            }
+        },
+        "/chats/reports": {
+            "get": {
+                "description": "Moderation queue of the administrator school, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chat"
+                ],
+                "summary": "Get message reports",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "pending, dismissed or actioned, every status by default",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageReport"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/chats/reports/{id}": {
             "put": {
-                "description": "Update course",
+                "description": "Dismiss or action a report, the reported message can be deleted at the same time",
                 "consumes": [
                     "application/json"
                 ],
@@ -1036,9 +1249,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Course"
+                    "Chat"
                 ],
-                "summary": "Update course",
+                "summary": "Review message report",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1048,133 +1261,137 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Course infos",
-                        "name": "course",
+                        "description": "Review",
+                        "name": "review",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.CourseUpdate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageReportReview"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Course"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageReport"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete course by id",
+            }
+        },
+        "/chats/search": {
+            "get": {
+                "description": "Full text search in the messages of the user channels, newest first. Matches are highlighted with \u003cmark\u003e tags in the snippets and the cursor of a result is given as before to the channel history to jump to it",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Course"
+                    "Chat"
                 ],
-                "summary": "Delete course by id",
+                "summary": "Search messages",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Cursor, only messages older than this message id",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default and 100 at most",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageSearchPage"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
             }
         },
-        "/documents": {
+        "/chats/students": {
             "get": {
-                "description": "Get all documents",
+                "description": "Get all possible students chatter",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Document"
+                    "Chat"
                 ],
-                "summary": "Get all documents",
+                "summary": "Get all possible students chatter",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Document"
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {}
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {}
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
-            },
-            "post": {
-                "description": "create new document",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/chats/teachers": {
+            "get": {
+                "description": "Get all possible teacher chatter",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Document"
-                ],
-                "summary": "Create new document",
-                "parameters": [
-                    {
-                        "description": "Document infos",
-                        "name": "document",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.DocumentCreate"
-                        }
-                    }
+                    "Chat"
                 ],
+                "summary": "Get all possible teacher chatter",
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Document"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {}
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -1182,116 +1399,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/documents/{id}": {
+        "/chats/unread": {
             "get": {
-                "description": "Get document by id",
+                "description": "Get the number of unread messages per channel and in total",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Document"
-                ],
-                "summary": "Get document by id",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Chat"
                 ],
+                "summary": "Get unread counts",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Document"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.UnreadSummary"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {}
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {}
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete document by id",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Document"
-                ],
-                "summary": "Delete document by id",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {}
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {}
-                    }
-                }
-            }
-        },
-        "/healthz": {
-            "get": {
-                "description": "Check if API is up",
-                "produces": [
-                    "application/json"
-                ],
-                "summary": "Check API health",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    }
-                }
             }
         },
-        "/informations": {
+        "/classes": {
             "get": {
-                "description": "Get all informations from schoolId",
+                "description": "Get all class",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Informations"
+                    "Class"
                 ],
-                "summary": "Get all informations from schoolId",
+                "summary": "Get all class",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Informations"
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
                             }
                         }
                     },
@@ -1300,11 +1448,9 @@ const docTemplate = `{
                         "schema": {}
                     }
                 }
-            }
-        },
-        "/informationss": {
+            },
             "post": {
-                "description": "create new informations",
+                "description": "create new class",
                 "consumes": [
                     "application/json"
                 ],
@@ -1312,17 +1458,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Informations"
+                    "Class"
                 ],
-                "summary": "Create new informations",
+                "summary": "Create new class",
                 "parameters": [
                     {
-                        "description": "Informations infos",
-                        "name": "informations",
+                        "description": "Class infos",
+                        "name": "class",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsCreate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ClassCreate"
                         }
                     }
                 ],
@@ -1330,7 +1476,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Informations"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
                         }
                     },
                     "400": {
@@ -1344,30 +1490,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/informationss/{id}": {
+        "/classes/student": {
             "get": {
-                "description": "Get informations by id",
+                "description": "Get class of student",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Informations"
-                ],
-                "summary": "Get informations by id",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Class"
                 ],
+                "summary": "Get class of user",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Informations"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
                         }
                     },
                     "400": {
@@ -1383,110 +1520,85 @@ const docTemplate = `{
                         "schema": {}
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete informations by id",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Informations"
-                ],
-                "summary": "Delete informations by id",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {}
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {}
-                    }
-                }
             }
         },
-        "/notes": {
+        "/classes/students/empty": {
             "get": {
-                "description": "Get all note",
+                "description": "Get school students without class",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Note"
+                    "Class"
                 ],
-                "summary": "Get all note",
+                "summary": "Get school students without class",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Note"
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
-            },
-            "post": {
-                "description": "create new note",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/classes/{id}": {
+            "get": {
+                "description": "Get class by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Note"
+                    "Class"
                 ],
-                "summary": "Create new note",
+                "summary": "Get class by id",
                 "parameters": [
                     {
-                        "description": "Note infos",
-                        "name": "note",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.NoteCreate"
-                        }
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Note"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
-            }
-        },
-        "/notes/{id}": {
+            },
             "put": {
-                "description": "Update note",
+                "description": "Update class",
                 "consumes": [
                     "application/json"
                 ],
@@ -1494,9 +1606,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Note"
+                    "Class"
                 ],
-                "summary": "Update note",
+                "summary": "Update class",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1506,12 +1618,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Note infos",
-                        "name": "note",
+                        "description": "Class infos",
+                        "name": "class",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.NoteUpdate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ClassUpdate"
                         }
                     }
                 ],
@@ -1519,7 +1631,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Note"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
                         }
                     },
                     "400": {
@@ -1533,14 +1645,14 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete note by id",
+                "description": "Delete class by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Note"
+                    "Class"
                 ],
-                "summary": "Delete note by id",
+                "summary": "Delete class by id",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1565,34 +1677,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/paths": {
-            "get": {
-                "description": "Get all path",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Path"
-                ],
-                "summary": "Get all path",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Path"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {}
-                    }
-                }
-            },
+        "/classes/{id}/add": {
             "post": {
-                "description": "create new path",
+                "description": "Add student to class",
                 "consumes": [
                     "application/json"
                 ],
@@ -1600,17 +1687,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Path"
+                    "Class"
                 ],
-                "summary": "Create new path",
+                "summary": "Add student to class",
                 "parameters": [
                     {
-                        "description": "Path infos",
-                        "name": "path",
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Student infos",
+                        "name": "class",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.PathCreate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ClassAdd"
                         }
                     }
                 ],
@@ -1618,7 +1712,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Path"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
                         }
                     },
                     "400": {
@@ -1632,48 +1726,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/paths/{id}": {
-            "get": {
-                "description": "Get path by id",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Path"
-                ],
-                "summary": "Get path by id",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Path"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {}
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {}
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {}
-                    }
-                }
-            },
-            "put": {
-                "description": "Update path",
+        "/classes/{id}/remove": {
+            "delete": {
+                "description": "Remove student from class",
                 "consumes": [
                     "application/json"
                 ],
@@ -1681,9 +1736,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Path"
+                    "Class"
                 ],
-                "summary": "Update path",
+                "summary": "Remove student form class",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1693,12 +1748,12 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Path infos",
-                        "name": "path",
+                        "description": "Student infos",
+                        "name": "class",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.PathUpdate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ClassRemove"
                         }
                     }
                 ],
@@ -1706,7 +1761,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Path"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
                         }
                     },
                     "400": {
@@ -1718,57 +1773,25 @@ const docTemplate = `{
                         "schema": {}
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete path by id",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Path"
-                ],
-                "summary": "Delete path by id",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {}
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {}
-                    }
-                }
             }
         },
-        "/projects": {
+        "/courses": {
             "get": {
-                "description": "Get all project",
+                "description": "Get all course",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Project"
+                    "Course"
                 ],
-                "summary": "Get all project",
+                "summary": "Get all course",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Project"
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Course"
                             }
                         }
                     },
@@ -1779,7 +1802,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "create new project",
+                "description": "create new course",
                 "consumes": [
                     "application/json"
                 ],
@@ -1787,17 +1810,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Project"
+                    "Course"
                 ],
-                "summary": "Create new project",
+                "summary": "Create new course",
                 "parameters": [
                     {
-                        "description": "Project infos",
-                        "name": "project",
+                        "description": "Course infos",
+                        "name": "course",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ProjectCreate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.CourseCreate"
                         }
                     }
                 ],
@@ -1805,7 +1828,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Project"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Course"
                         }
                     },
                     "400": {
@@ -1819,16 +1842,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{id}": {
+        "/courses/{id}": {
             "get": {
-                "description": "Get project by id",
+                "description": "Get course by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Project"
+                    "Course"
                 ],
-                "summary": "Get project by id",
+                "summary": "Get course by id",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1842,7 +1865,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Project"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Course"
                         }
                     },
                     "400": {
@@ -1860,7 +1883,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update project",
+                "description": "Update course",
                 "consumes": [
                     "application/json"
                 ],
@@ -1868,9 +1891,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Project"
+                    "Course"
                 ],
-                "summary": "Update project",
+                "summary": "Update course",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1878,13 +1901,22 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Course infos",
+                        "name": "course",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.CourseUpdate"
+                        }
                     }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Project"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Course"
                         }
                     },
                     "400": {
@@ -1898,14 +1930,14 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete project by id",
+                "description": "Delete course by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Project"
+                    "Course"
                 ],
-                "summary": "Delete project by id",
+                "summary": "Delete course by id",
                 "parameters": [
                     {
                         "type": "integer",
@@ -1930,32 +1962,23 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{id}/groups": {
+        "/documents": {
             "get": {
-                "description": "Get project's groups",
+                "description": "Get all documents",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Project"
-                ],
-                "summary": "Get project's groups",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Document"
                 ],
+                "summary": "Get all documents",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ProjectGroup"
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Document"
                             }
                         }
                     },
@@ -1972,11 +1995,9 @@ const docTemplate = `{
                         "schema": {}
                     }
                 }
-            }
-        },
-        "/projects/{id}/join": {
+            },
             "post": {
-                "description": "Join project",
+                "description": "create new document",
                 "consumes": [
                     "application/json"
                 ],
@@ -1984,17 +2005,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Project"
+                    "Document"
                 ],
-                "summary": "Join project",
+                "summary": "Create new document",
                 "parameters": [
                     {
-                        "description": "Join infos",
-                        "name": "project",
+                        "description": "Document infos",
+                        "name": "document",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ProjectStudentCreate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.DocumentCreate"
                         }
                     }
                 ],
@@ -2002,7 +2023,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ProjectStudent"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Document"
                         }
                     },
                     "400": {
@@ -2016,105 +2037,126 @@ const docTemplate = `{
                 }
             }
         },
-        "/projects/{id}/quit": {
+        "/documents/reconcile": {
             "post": {
-                "description": "Quit project",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Report the bucket files no document references and the documents whose file is missing, clean removes them",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Project"
+                    "Document"
                 ],
-                "summary": "Quit project",
+                "summary": "Reconcile documents storage",
                 "parameters": [
                     {
-                        "description": "Join infos",
-                        "name": "project",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ProjectStudentCreate"
-                        }
+                        "type": "boolean",
+                        "description": "Delete orphaned files and dangling documents",
+                        "name": "clean",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.DocumentReconcileReport"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
             }
         },
-        "/schedules": {
+        "/documents/search": {
             "get": {
-                "description": "Get all schedule",
+                "description": "Full text search on the name and content of the documents of the user school, matches are highlighted with \u003cmark\u003e tags in the snippets",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Schedule"
+                    "Document"
                 ],
-                "summary": "Get all schedule",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "Search documents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.DocumentSearchResult"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
-            },
+            }
+        },
+        "/documents/uploads": {
             "post": {
-                "description": "create new schedule",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "tus creation request, the file name and the optional course id are given base64 encoded in Upload-Metadata (\"name \u003cb64\u003e,courseId \u003cb64\u003e\")",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Schedule"
+                    "Document"
                 ],
-                "summary": "Create new schedule",
+                "summary": "Start a resumable upload",
                 "parameters": [
                     {
-                        "description": "Schedule infos",
-                        "name": "schedule",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ScheduleCreate"
-                        }
+                        "type": "integer",
+                        "description": "Size of the file in bytes",
+                        "name": "Upload-Length",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "tus metadata",
+                        "name": "Upload-Metadata",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.DocumentUpload"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -2122,30 +2164,91 @@ const docTemplate = `{
                 }
             }
         },
-        "/schedules/": {
-            "get": {
-                "description": "Get UnAttended Schedules",
+        "/documents/uploads/{id}": {
+            "head": {
+                "description": "tus HEAD request, the number of bytes already received is returned in the Upload-Offset header",
+                "tags": [
+                    "Document"
+                ],
+                "summary": "Get a resumable upload offset",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not Found"
+                    }
+                }
+            },
+            "patch": {
+                "description": "tus PATCH request, the body is appended at Upload-Offset. Answers 204 while the upload is incomplete and 201 with the created document after the last chunk",
                 "consumes": [
-                    "application/json"
+                    "application/offset+octet-stream"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Schedule"
+                    "Document"
+                ],
+                "summary": "Send a chunk of a resumable upload",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset of the chunk",
+                        "name": "Upload-Offset",
+                        "in": "header",
+                        "required": true
+                    }
                 ],
-                "summary": "Get UnAttended Schedules",
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Document"
                         }
                     },
+                    "204": {
+                        "description": "No Content"
+                    },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {}
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -2153,16 +2256,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/schedules/{id}": {
+        "/documents/{id}": {
             "get": {
-                "description": "Get schedule by id",
+                "description": "Get document by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Schedule"
+                    "Document"
                 ],
-                "summary": "Get schedule by id",
+                "summary": "Get document by id",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2176,7 +2279,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Document"
                         }
                     },
                     "400": {
@@ -2193,18 +2296,15 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
-                "description": "Update schedule",
-                "consumes": [
-                    "application/json"
-                ],
+            "delete": {
+                "description": "Delete document by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Schedule"
+                    "Document"
                 ],
-                "summary": "Update schedule",
+                "summary": "Delete document by id",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2212,43 +2312,33 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Schedule infos",
-                        "name": "schedule",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ScheduleUpdate"
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
-                        }
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {}
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete schedule by id",
+            }
+        },
+        "/documents/{id}/download": {
+            "get": {
+                "description": "Download document by id, only once it has been scanned and is clean",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "Schedule"
+                    "Document"
                 ],
-                "summary": "Delete schedule by id",
+                "summary": "Download document by id",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2260,63 +2350,80 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {}
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
                     }
                 }
             }
         },
-        "/schedules/{id}/code": {
+        "/events": {
             "get": {
-                "description": "Get schedule by id",
+                "description": "Get the events of the school with their answers count, soonest first. Students and teachers only get the ones targeting them, with their own answer",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Schedule"
+                    "Event"
                 ],
-                "summary": "Get schedule by id",
+                "summary": "Get all events",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Unix timestamp, events ending after it, now by default",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Unix timestamp, events starting before it",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Event"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {}
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
-            }
-        },
-        "/schedules/{id}/sign": {
+            },
             "post": {
-                "description": "Check Sign for schedule",
+                "description": "Create an open day, a jury or a party on a campus, targeted at classes, paths or user kinds. Users going once the capacity is reached are waitlisted, no limit when 0. The audience is notified",
                 "consumes": [
                     "application/json"
                 ],
@@ -2324,17 +2431,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Schedule"
+                    "Event"
                 ],
-                "summary": "Check Sign for schedule",
+                "summary": "Create new event",
                 "parameters": [
                     {
-                        "description": "Schedule infos",
-                        "name": "schedule",
+                        "description": "Event infos",
+                        "name": "event",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ScheduleCreate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventCreate"
                         }
                     }
                 ],
@@ -2342,7 +2449,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Event"
                         }
                     },
                     "400": {
@@ -2356,16 +2463,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/schedules/{id}/students": {
+        "/events/{id}": {
             "get": {
-                "description": "Get schedule's students by id",
+                "description": "Get event by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Schedule"
+                    "Event"
                 ],
-                "summary": "Get schedule's students by id",
+                "summary": "Get event by id",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2379,10 +2486,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
-                            }
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Event"
                         }
                     },
                     "400": {
@@ -2398,33 +2502,9 @@ const docTemplate = `{
                         "schema": {}
                     }
                 }
-            }
-        },
-        "/schools": {
-            "get": {
-                "description": "Get by user",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "School"
-                ],
-                "summary": "Get by user",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.School"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {}
-                    }
-                }
             },
-            "post": {
-                "description": "create new school",
+            "put": {
+                "description": "Update the event, its audience can't change. Waitlisted users get the places added by a bigger capacity",
                 "consumes": [
                     "application/json"
                 ],
@@ -2432,73 +2512,82 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "School"
+                    "Event"
                 ],
-                "summary": "Create new school",
+                "summary": "Update event",
                 "parameters": [
                     {
-                        "description": "School infos",
-                        "name": "school",
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Event infos",
+                        "name": "event",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.SchoolCreate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventUpdate"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.School"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Event"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
-            }
-        },
-        "/schools/add/:kind": {
-            "post": {
-                "description": "Add a user to the school",
-                "consumes": [
-                    "application/json"
-                ],
+            },
+            "delete": {
+                "description": "Delete event by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "School"
+                    "Event"
                 ],
-                "summary": "Add a user to the school",
+                "summary": "Delete event by id",
                 "parameters": [
                     {
-                        "description": "School infos",
-                        "name": "school",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.SchoolUserCreate"
-                        }
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
-                        }
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -2506,41 +2595,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/schools/invite": {
-            "post": {
-                "description": "Invite a student to the school",
-                "consumes": [
-                    "application/json"
-                ],
+        "/events/{id}/attendees": {
+            "get": {
+                "description": "Get the answers of the audience by status, the waitlist in its order",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "School"
+                    "Event"
                 ],
-                "summary": "Invite a student to the school",
+                "summary": "Get event attendees",
                 "parameters": [
                     {
-                        "description": "School infos",
-                        "name": "school",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.SchoolInvite"
-                        }
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.SchoolInvite"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventAttendees"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -2548,9 +2640,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/schools/student/{id}": {
+        "/events/{id}/rsvp": {
             "put": {
-                "description": "Update a student of the school",
+                "description": "Answer going, notGoing or maybe to an event that is not over, for students and teachers. Users going once the event is full are waitlisted and get the first freed places, oldest answer first",
                 "consumes": [
                     "application/json"
                 ],
@@ -2558,31 +2650,46 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "School"
+                    "Event"
                 ],
-                "summary": "Update a student of the school",
+                "summary": "Answer an event",
                 "parameters": [
                     {
-                        "description": "User update infos",
-                        "name": "school",
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Answer",
+                        "name": "rsvp",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.SchoolUserUpdate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventRsvpUpdate"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventRsvp"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -2590,16 +2697,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/schools/{id}": {
+        "/healthz": {
             "get": {
-                "description": "Get school by id",
+                "description": "Check if API is up",
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Check API health",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/informations": {
+            "get": {
+                "description": "Get the informations of the school, pinned first. Students and teachers only get the published and not expired ones targeting them",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "School"
+                    "Informations"
                 ],
-                "summary": "Get school by id",
+                "summary": "Get all informations from schoolId",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Informations"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/informations/{id}": {
+            "put": {
+                "description": "Update the informations, the description is Markdown. The previous title and description are kept in the revisions when they change",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Informations"
+                ],
+                "summary": "Update informations",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2607,19 +2764,32 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Informations infos",
+                        "name": "informations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsUpdate"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.School"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Informations"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {}
@@ -2629,16 +2799,18 @@ const docTemplate = `{
                         "schema": {}
                     }
                 }
-            },
-            "delete": {
-                "description": "Delete school by id",
+            }
+        },
+        "/informations/{id}/acknowledge": {
+            "post": {
+                "description": "Confirm the user read informations requiring an acknowledgement, the informations is read too",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "School"
+                    "Informations"
                 ],
-                "summary": "Delete school by id",
+                "summary": "Acknowledge informations",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2650,29 +2822,40 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsReceipt"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
                     }
                 }
             }
         },
-        "/schools/{kind}/{id}": {
-            "delete": {
-                "description": "Remove student from school",
+        "/informations/{id}/read": {
+            "post": {
+                "description": "Record that the user read the informations, the first read date is kept",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "School"
+                    "Informations"
                 ],
-                "summary": "Remove student from school",
+                "summary": "Mark informations as read",
                 "parameters": [
                     {
                         "type": "integer",
@@ -2684,54 +2867,68 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsReceipt"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
                     }
                 }
             }
         },
-        "/user/me": {
-            "put": {
-                "description": "Update me",
-                "consumes": [
-                    "application/json"
-                ],
+        "/informations/{id}/receipts": {
+            "get": {
+                "description": "Get the read and acknowledgement counts of the informations with the users of its audience who did not acknowledge yet, or did not read yet when no acknowledgement is required",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Path"
+                    "Informations"
                 ],
-                "summary": "Update me",
+                "summary": "Get informations receipts",
                 "parameters": [
                     {
-                        "description": "Me infos",
-                        "name": "path",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.UpdateMe"
-                        }
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsReceipts"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -2739,41 +2936,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/me/password": {
-            "put": {
-                "description": "Update me password",
-                "consumes": [
-                    "application/json"
-                ],
+        "/informations/{id}/remind": {
+            "post": {
+                "description": "Notify again the users of the audience who did not acknowledge yet, or did not read yet when no acknowledgement is required",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Path"
+                    "Informations"
                 ],
-                "summary": "Update me password",
+                "summary": "Send informations reminder",
                 "parameters": [
                     {
-                        "description": "Me password",
-                        "name": "path",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.UpdatePasswordMe"
-                        }
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
-                        }
+                    "200": {
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {}
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
@@ -2781,34 +2978,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/users": {
+        "/informations/{id}/revisions": {
             "get": {
-                "description": "Get all users",
+                "description": "Get the informations with its previous titles and descriptions, oldest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Informations"
                 ],
-                "summary": "Get all users",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "Get informations revisions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
-                            }
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsHistory"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
-            },
+            }
+        },
+        "/informationss": {
             "post": {
-                "description": "Create User",
+                "description": "create new informations, targeted at classes, paths, campuses or user kinds and published now or at publishAt. The audience is notified once published",
                 "consumes": [
                     "application/json"
                 ],
@@ -2816,17 +3029,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Informations"
                 ],
-                "summary": "Create user",
+                "summary": "Create new informations",
                 "parameters": [
                     {
-                        "description": "User Infos",
-                        "name": "user",
+                        "description": "Informations infos",
+                        "name": "informations",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.UserCreate"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsCreate"
                         }
                     }
                 ],
@@ -2834,7 +3047,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Informations"
                         }
                     },
                     "400": {
@@ -2848,77 +3061,137 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me": {
+        "/informationss/{id}": {
             "get": {
-                "description": "Get me",
+                "description": "Get informations by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Informations"
+                ],
+                "summary": "Get informations by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get me",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Informations"
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {}
                     }
                 }
-            }
-        },
-        "/users/reset-link": {
-            "post": {
-                "description": "Get all users",
+            },
+            "delete": {
+                "description": "Delete informations by id",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Informations"
+                ],
+                "summary": "Delete informations by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get all users",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {}
                     }
                 }
             }
         },
-        "/users/{kind}": {
+        "/notes": {
             "get": {
-                "description": "Get school students",
+                "description": "Get all note",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "School"
+                    "Note"
                 ],
-                "summary": "Get school students",
+                "summary": "Get all note",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Note"
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {}
+                    }
+                }
+            },
+            "post": {
+                "description": "create new note",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Note"
+                ],
+                "summary": "Create new note",
+                "parameters": [
+                    {
+                        "description": "Note infos",
+                        "name": "note",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.NoteCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Note"
+                        }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {}
                     },
                     "500": {
@@ -2927,74 +3200,2865 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_esgi-challenge_backend_internal_models.Auth": {
-            "type": "object",
-            "required": [
-                "token"
-            ],
-            "properties": {
-                "token": {
-                    "type": "string"
-                }
-            }
         },
-        "github_com_esgi-challenge_backend_internal_models.AuthInvitationCode": {
-            "type": "object",
-            "required": [
-                "invitationCode",
-                "password"
-            ],
-            "properties": {
-                "invitationCode": {
-                    "type": "string"
-                },
-                "password": {
-                    "type": "string"
+        "/notes/{id}": {
+            "put": {
+                "description": "Update note",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Note"
+                ],
+                "summary": "Update note",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Note infos",
+                        "name": "note",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.NoteUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Note"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete note by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Note"
+                ],
+                "summary": "Delete note by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    }
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.AuthLogin": {
-            "type": "object",
+        "/notifications": {
+            "get": {
+                "description": "Get the notifications of the user, newest first, with the count of unread ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notification"
+                ],
+                "summary": "Get notifications",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only the unread notifications",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Cursor, only notifications older than this notification id",
+                        "name": "before",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size, 50 by default and 100 at most",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.NotificationPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/notifications/devices": {
+            "get": {
+                "description": "Get the devices registered by the user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notification"
+                ],
+                "summary": "Get push devices",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.PushDevice"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "post": {
+                "description": "Register the token of a device to receive push notifications while the user has no open socket. A token already registered is moved to the user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notification"
+                ],
+                "summary": "Register push device",
+                "parameters": [
+                    {
+                        "description": "Device infos",
+                        "name": "device",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.PushDeviceCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.PushDevice"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/notifications/devices/{token}": {
+            "delete": {
+                "description": "Stop sending push notifications to the device, on logout",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notification"
+                ],
+                "summary": "Unregister push device",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Device token",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/notifications/preferences": {
+            "get": {
+                "description": "Get how the user is told about each type of event, types never changed have the default preference: in-app and push, no email",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notification"
+                ],
+                "summary": "Get notification preferences",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.NotificationPreference"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/notifications/preferences/{type}": {
+            "put": {
+                "description": "Choose how the user is told about a type of event (note, schedule, informations, project, event or chat): in-app, push, and an email sent instantly or gathered in a daily or weekly digest",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notification"
+                ],
+                "summary": "Update notification preference",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification type",
+                        "name": "type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Preference",
+                        "name": "preference",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.NotificationPreferenceUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.NotificationPreference"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/notifications/read-all": {
+            "post": {
+                "description": "Mark every notification of the user read, a readAll event is sent to the notification sockets of the user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notification"
+                ],
+                "summary": "Mark all notifications as read",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/read": {
+            "post": {
+                "description": "Mark the notification read, a read event is sent to the notification sockets of the user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notification"
+                ],
+                "summary": "Mark notification as read",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Notification"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/outbox/dead": {
+            "get": {
+                "description": "Get the emails of the outbox that failed too many times to be sent, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Outbox"
+                ],
+                "summary": "Get dead emails",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Email"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/outbox/{id}/retry": {
+            "post": {
+                "description": "Send the dead email again with a new set of attempts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Outbox"
+                ],
+                "summary": "Retry dead email",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Email"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/paths": {
+            "get": {
+                "description": "Get all path",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Path"
+                ],
+                "summary": "Get all path",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Path"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "post": {
+                "description": "create new path",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Path"
+                ],
+                "summary": "Create new path",
+                "parameters": [
+                    {
+                        "description": "Path infos",
+                        "name": "path",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.PathCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Path"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/paths/{id}": {
+            "get": {
+                "description": "Get path by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Path"
+                ],
+                "summary": "Get path by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Path"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "put": {
+                "description": "Update path",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Path"
+                ],
+                "summary": "Update path",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Path infos",
+                        "name": "path",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.PathUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Path"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete path by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Path"
+                ],
+                "summary": "Delete path by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/projects": {
+            "get": {
+                "description": "Get all project",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Get all project",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Project"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "post": {
+                "description": "create new project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Create new project",
+                "parameters": [
+                    {
+                        "description": "Project infos",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ProjectCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Project"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/projects/{id}": {
+            "get": {
+                "description": "Get project by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Get project by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Project"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "put": {
+                "description": "Update project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Update project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Project"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete project by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Delete project by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/projects/{id}/groups": {
+            "get": {
+                "description": "Get project's groups",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Get project's groups",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ProjectGroup"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/projects/{id}/join": {
+            "post": {
+                "description": "Join project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Join project",
+                "parameters": [
+                    {
+                        "description": "Join infos",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ProjectStudentCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ProjectStudent"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/projects/{id}/quit": {
+            "post": {
+                "description": "Quit project",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Project"
+                ],
+                "summary": "Quit project",
+                "parameters": [
+                    {
+                        "description": "Join infos",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ProjectStudentCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/schedules": {
+            "get": {
+                "description": "Get all schedule. With events, a timetable of the schedules and of the events of the period targeting the user is returned instead, every event of the school for its administrator",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Get all schedule",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include the events",
+                        "name": "events",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Unix timestamp, events ending after it, now by default",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Unix timestamp, events starting before it",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "post": {
+                "description": "create new schedule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Create new schedule",
+                "parameters": [
+                    {
+                        "description": "Schedule infos",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ScheduleCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/schedules/": {
+            "get": {
+                "description": "Get UnAttended Schedules",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Get UnAttended Schedules",
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/schedules/{id}": {
+            "get": {
+                "description": "Get schedule by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Get schedule by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "put": {
+                "description": "Update schedule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Update schedule",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Schedule infos",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ScheduleUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete schedule by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Delete schedule by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/schedules/{id}/code": {
+            "get": {
+                "description": "Get schedule by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Get schedule by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/schedules/{id}/sign": {
+            "post": {
+                "description": "Check Sign for schedule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Check Sign for schedule",
+                "parameters": [
+                    {
+                        "description": "Schedule infos",
+                        "name": "schedule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ScheduleCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Schedule"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/schedules/{id}/students": {
+            "get": {
+                "description": "Get schedule's students by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Schedule"
+                ],
+                "summary": "Get schedule's students by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/schools": {
+            "get": {
+                "description": "Get by user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "School"
+                ],
+                "summary": "Get by user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.School"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "post": {
+                "description": "create new school",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "School"
+                ],
+                "summary": "Create new school",
+                "parameters": [
+                    {
+                        "description": "School infos",
+                        "name": "school",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.SchoolCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.School"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/schools/add/:kind": {
+            "post": {
+                "description": "Add a user to the school",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "School"
+                ],
+                "summary": "Add a user to the school",
+                "parameters": [
+                    {
+                        "description": "School infos",
+                        "name": "school",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.SchoolUserCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/schools/invite": {
+            "post": {
+                "description": "Invite a student to the school",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "School"
+                ],
+                "summary": "Invite a student to the school",
+                "parameters": [
+                    {
+                        "description": "School infos",
+                        "name": "school",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.SchoolInvite"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.SchoolInvite"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/schools/student/{id}": {
+            "put": {
+                "description": "Update a student of the school",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "School"
+                ],
+                "summary": "Update a student of the school",
+                "parameters": [
+                    {
+                        "description": "User update infos",
+                        "name": "school",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.SchoolUserUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/schools/{id}": {
+            "get": {
+                "description": "Get school by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "School"
+                ],
+                "summary": "Get school by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.School"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete school by id",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "School"
+                ],
+                "summary": "Delete school by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/schools/{kind}/{id}": {
+            "delete": {
+                "description": "Remove student from school",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "School"
+                ],
+                "summary": "Remove student from school",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/user/me": {
+            "put": {
+                "description": "Update me",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Path"
+                ],
+                "summary": "Update me",
+                "parameters": [
+                    {
+                        "description": "Me infos",
+                        "name": "path",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.UpdateMe"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/user/me/password": {
+            "put": {
+                "description": "Update me password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Path"
+                ],
+                "summary": "Update me password",
+                "parameters": [
+                    {
+                        "description": "Me password",
+                        "name": "path",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.UpdatePasswordMe"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "description": "Get all users",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get all users",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            },
+            "post": {
+                "description": "Create User",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Create user",
+                "parameters": [
+                    {
+                        "description": "User Infos",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.UserCreate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/users/me": {
+            "get": {
+                "description": "Get me",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get me",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/users/reset-link": {
+            "post": {
+                "description": "Get all users",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get all users",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        },
+        "/users/{kind}": {
+            "get": {
+                "description": "Get school students",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "School"
+                ],
+                "summary": "Get school students",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {}
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {}
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {}
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_esgi-challenge_backend_internal_models.Auth": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.AuthInvitationCode": {
+            "type": "object",
+            "required": [
+                "invitationCode",
+                "password"
+            ],
+            "properties": {
+                "invitationCode": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.AuthLogin": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.AuthRegister": {
+            "type": "object",
+            "required": [
+                "email",
+                "firstname",
+                "lastname",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "firstname": {
+                    "type": "string"
+                },
+                "lastname": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.Campus": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "schoolId": {
+                    "type": "integer"
+                },
+                "timezone": {
+                    "description": "IANA name, the times of the courses and events of the campus are written in it",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.CampusCreate": {
+            "type": "object",
+            "required": [
+                "location",
+                "name"
+            ],
+            "properties": {
+                "latitude": {
+                    "type": "number"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "Europe/Paris when empty",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.CampusUpdate": {
+            "type": "object",
+            "required": [
+                "location",
+                "name"
+            ],
+            "properties": {
+                "latitude": {
+                    "type": "number"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "Europe/Paris when empty",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.Channel": {
+            "type": "object",
+            "properties": {
+                "classId": {
+                    "type": "integer"
+                },
+                "courseId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "firstUser": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                },
+                "firstUserId": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ChannelKind"
+                },
+                "lastMessage": {
+                    "description": "Filled for the requesting user, the history is paginated with MessagePage",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Message"
+                        }
+                    ]
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ChannelMember"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "projectGroup": {
+                    "type": "integer"
+                },
+                "projectId": {
+                    "type": "integer"
+                },
+                "secondUser": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                },
+                "secondUserId": {
+                    "type": "integer"
+                },
+                "unreadCount": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.ChannelCreate": {
+            "type": "object",
+            "properties": {
+                "firstUserId": {
+                    "type": "integer"
+                },
+                "secondUserId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.ChannelKind": {
+            "type": "string",
+            "enum": [
+                "direct",
+                "class",
+                "course",
+                "project_group"
+            ],
+            "x-enum-varnames": [
+                "CHANNEL_DIRECT",
+                "CHANNEL_CLASS",
+                "CHANNEL_COURSE",
+                "CHANNEL_PROJECT_GROUP"
+            ]
+        },
+        "github_com_esgi-challenge_backend_internal_models.ChannelMember": {
+            "type": "object",
+            "properties": {
+                "channelId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "lastReadMessageId": {
+                    "description": "Messages after this one are unread, nil when nothing was read yet",
+                    "type": "integer"
+                },
+                "user": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.ChannelRead": {
+            "type": "object",
+            "properties": {
+                "messageId": {
+                    "description": "Latest message of the channel when not set",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.ChannelUnread": {
+            "type": "object",
+            "properties": {
+                "channelId": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.ChatMute": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "mutedById": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "schoolId": {
+                    "type": "integer"
+                },
+                "until": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.ChatMuteCreate": {
+            "type": "object",
+            "required": [
+                "until",
+                "userId"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1024
+                },
+                "until": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.Class": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pathId": {
+                    "type": "integer"
+                },
+                "schoolId": {
+                    "type": "integer"
+                },
+                "students": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                    }
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.ClassAdd": {
+            "type": "object",
             "required": [
-                "email",
-                "password"
+                "userId"
+            ],
+            "properties": {
+                "userId": {
+                    "description": "Do like this to allow zero value",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.ClassCreate": {
+            "type": "object",
+            "required": [
+                "name",
+                "pathId"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "pathId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.ClassRemove": {
+            "type": "object",
+            "required": [
+                "userId"
+            ],
+            "properties": {
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.ClassUpdate": {
+            "type": "object",
+            "required": [
+                "name",
+                "pathId"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "pathId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.Course": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "path": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Path"
+                },
+                "pathId": {
+                    "type": "integer"
+                },
+                "schoolId": {
+                    "type": "integer"
+                },
+                "teacher": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                },
+                "teacherId": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.CourseCreate": {
+            "type": "object",
+            "required": [
+                "description",
+                "name",
+                "pathId",
+                "teacherId"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pathId": {
+                    "type": "integer"
+                },
+                "teacherId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.CourseUpdate": {
+            "type": "object",
+            "required": [
+                "description",
+                "name",
+                "pathId",
+                "teacherId"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "pathId": {
+                    "type": "integer"
+                },
+                "teacherId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.Document": {
+            "type": "object",
+            "properties": {
+                "contentType": {
+                    "type": "string"
+                },
+                "course": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Course"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "previewUrl": {
+                    "type": "string"
+                },
+                "scanStatus": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.DocumentScanStatus"
+                },
+                "schoolId": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.DocumentCreate": {
+            "type": "object",
+            "properties": {
+                "byte": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "courseId": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.DocumentReconcileReport": {
+            "type": "object",
+            "properties": {
+                "cleaned": {
+                    "type": "boolean"
+                },
+                "danglingDocuments": {
+                    "description": "Documents whose file is missing from the bucket",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "orphanedFiles": {
+                    "description": "Files in the bucket that no document references",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.DocumentScanStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "clean",
+                "infected"
+            ],
+            "x-enum-varnames": [
+                "DOCUMENT_SCAN_PENDING",
+                "DOCUMENT_SCAN_CLEAN",
+                "DOCUMENT_SCAN_INFECTED"
+            ]
+        },
+        "github_com_esgi-challenge_backend_internal_models.DocumentSearchResult": {
+            "type": "object",
+            "properties": {
+                "contentType": {
+                    "type": "string"
+                },
+                "course": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Course"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "previewUrl": {
+                    "type": "string"
+                },
+                "rank": {
+                    "type": "number"
+                },
+                "scanStatus": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.DocumentScanStatus"
+                },
+                "schoolId": {
+                    "type": "integer"
+                },
+                "snippet": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.DocumentUpload": {
+            "type": "object",
+            "properties": {
+                "courseId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "documentId": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "length": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.Email": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "locale": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Locale"
+                },
+                "nextAttemptAt": {
+                    "type": "string"
+                },
+                "sentAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EmailStatus"
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "template": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.EmailFrequency": {
+            "type": "string",
+            "enum": [
+                "never",
+                "instant",
+                "daily",
+                "weekly"
+            ],
+            "x-enum-varnames": [
+                "EMAIL_NEVER",
+                "EMAIL_INSTANT",
+                "EMAIL_DAILY",
+                "EMAIL_WEEKLY"
+            ]
+        },
+        "github_com_esgi-challenge_backend_internal_models.EmailStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "sent",
+                "dead"
             ],
+            "x-enum-varnames": [
+                "EMAIL_PENDING",
+                "EMAIL_SENT",
+                "EMAIL_DEAD"
+            ]
+        },
+        "github_com_esgi-challenge_backend_internal_models.Event": {
+            "type": "object",
             "properties": {
-                "email": {
+                "campus": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Campus"
+                },
+                "campusId": {
+                    "type": "integer"
+                },
+                "capacity": {
+                    "description": "Users going once it is full are waitlisted, no limit when 0",
+                    "type": "integer"
+                },
+                "createdAt": {
                     "type": "string"
                 },
-                "password": {
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "endAt": {
+                    "type": "string"
+                },
+                "going": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "maybe": {
+                    "type": "integer"
+                },
+                "notGoing": {
+                    "type": "integer"
+                },
+                "rsvp": {
+                    "description": "Answer of the user, not loaded for the administrator",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventRsvp"
+                        }
+                    ]
+                },
+                "schoolId": {
+                    "type": "integer"
+                },
+                "startAt": {
+                    "type": "string"
+                },
+                "targets": {
+                    "description": "Whole school when empty",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventTarget"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
                     "type": "string"
+                },
+                "waitlisted": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.AuthRegister": {
+        "github_com_esgi-challenge_backend_internal_models.EventAttendees": {
+            "type": "object",
+            "properties": {
+                "going": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventRsvp"
+                    }
+                },
+                "maybe": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventRsvp"
+                    }
+                },
+                "notGoing": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventRsvp"
+                    }
+                },
+                "waitlist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventRsvp"
+                    }
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.EventCreate": {
             "type": "object",
             "required": [
-                "email",
-                "firstname",
-                "lastname",
-                "password"
+                "campusId",
+                "endAt",
+                "startAt",
+                "title"
             ],
             "properties": {
-                "email": {
+                "campusId": {
+                    "type": "integer"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "description": {
                     "type": "string"
                 },
-                "firstname": {
+                "endAt": {
+                    "type": "integer"
+                },
+                "startAt": {
+                    "description": "Unix timestamps",
+                    "type": "integer"
+                },
+                "targets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventTargetCreate"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 128
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.EventRsvp": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
                     "type": "string"
                 },
-                "lastname": {
+                "eventId": {
+                    "type": "integer"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventRsvpStatus"
+                },
+                "updatedAt": {
                     "type": "string"
                 },
-                "password": {
+                "user": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                },
+                "userId": {
+                    "type": "integer"
+                },
+                "waitlisted": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.EventRsvpStatus": {
+            "type": "string",
+            "enum": [
+                "going",
+                "notGoing",
+                "maybe"
+            ],
+            "x-enum-varnames": [
+                "EVENT_RSVP_GOING",
+                "EVENT_RSVP_NOT_GOING",
+                "EVENT_RSVP_MAYBE"
+            ]
+        },
+        "github_com_esgi-challenge_backend_internal_models.EventRsvpUpdate": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "enum": [
+                        "going",
+                        "notGoing",
+                        "maybe"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventRsvpStatus"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.EventTarget": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventTargetKind"
+                },
+                "targetId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.EventTargetCreate": {
+            "type": "object",
+            "required": [
+                "kind",
+                "targetId"
+            ],
+            "properties": {
+                "kind": {
+                    "enum": [
+                        "class",
+                        "path",
+                        "userKind"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EventTargetKind"
+                        }
+                    ]
+                },
+                "targetId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.EventTargetKind": {
+            "type": "string",
+            "enum": [
+                "class",
+                "path",
+                "userKind"
+            ],
+            "x-enum-varnames": [
+                "EVENT_TARGET_CLASS",
+                "EVENT_TARGET_PATH",
+                "EVENT_TARGET_USER_KIND"
+            ]
+        },
+        "github_com_esgi-challenge_backend_internal_models.EventUpdate": {
+            "type": "object",
+            "required": [
+                "campusId",
+                "endAt",
+                "startAt",
+                "title"
+            ],
+            "properties": {
+                "campusId": {
+                    "type": "integer"
+                },
+                "capacity": {
+                    "type": "integer"
+                },
+                "description": {
                     "type": "string"
+                },
+                "endAt": {
+                    "type": "integer"
+                },
+                "startAt": {
+                    "description": "Unix timestamps",
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 128
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.Campus": {
+        "github_com_esgi-challenge_backend_internal_models.Informations": {
             "type": "object",
             "properties": {
                 "createdAt": {
@@ -3003,233 +6067,359 @@ const docTemplate = `{
                 "deletedAt": {
                     "$ref": "#/definitions/gorm.DeletedAt"
                 },
+                "description": {
+                    "description": "Markdown source, only show DescriptionHtml as HTML",
+                    "type": "string"
+                },
+                "descriptionHtml": {
+                    "type": "string"
+                },
+                "document": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Document"
+                },
+                "documentId": {
+                    "type": "integer"
+                },
+                "editedAt": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "description": "Hidden from the students and teachers after, never expires when nil",
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "latitude": {
-                    "type": "number"
+                "pinned": {
+                    "type": "boolean"
                 },
-                "location": {
+                "publishAt": {
+                    "description": "Hidden from the students and teachers before",
                     "type": "string"
                 },
-                "longitude": {
-                    "type": "number"
+                "receipt": {
+                    "description": "Receipt of the user reading the informations, not loaded for the administrator",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsReceipt"
+                        }
+                    ]
                 },
-                "name": {
-                    "type": "string"
+                "requiresAck": {
+                    "description": "The audience must confirm they read it, like exam rules",
+                    "type": "boolean"
                 },
                 "schoolId": {
                     "type": "integer"
                 },
+                "targets": {
+                    "description": "Whole school when empty",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsTarget"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
                 "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.CampusCreate": {
+        "github_com_esgi-challenge_backend_internal_models.InformationsCreate": {
             "type": "object",
             "required": [
-                "location",
-                "name"
+                "description",
+                "title"
             ],
             "properties": {
-                "latitude": {
-                    "type": "number"
-                },
-                "location": {
+                "description": {
                     "type": "string"
                 },
-                "longitude": {
-                    "type": "number"
+                "documentId": {
+                    "type": "integer"
                 },
-                "name": {
+                "expiresAt": {
+                    "type": "integer"
+                },
+                "pinned": {
+                    "type": "boolean"
+                },
+                "publishAt": {
+                    "description": "Unix timestamps, published now when not set",
+                    "type": "integer"
+                },
+                "requiresAck": {
+                    "type": "boolean"
+                },
+                "targets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsTargetCreate"
+                    }
+                },
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.CampusUpdate": {
+        "github_com_esgi-challenge_backend_internal_models.InformationsHistory": {
             "type": "object",
-            "required": [
-                "location",
-                "name"
-            ],
             "properties": {
-                "latitude": {
-                    "type": "number"
-                },
-                "location": {
-                    "type": "string"
-                },
-                "longitude": {
-                    "type": "number"
+                "informations": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Informations"
                 },
-                "name": {
-                    "type": "string"
+                "revisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsRevision"
+                    }
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.Channel": {
+        "github_com_esgi-challenge_backend_internal_models.InformationsPendingUser": {
             "type": "object",
             "properties": {
+                "class": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Class"
+                },
+                "classRefer": {
+                    "type": "integer"
+                },
                 "createdAt": {
                     "type": "string"
                 },
                 "deletedAt": {
                     "$ref": "#/definitions/gorm.DeletedAt"
                 },
-                "firstUser": {
-                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                "email": {
+                    "type": "string"
                 },
-                "firstUserId": {
-                    "type": "integer"
+                "firstname": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "messages": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Message"
-                    }
+                "lastname": {
+                    "type": "string"
                 },
-                "secondUser": {
-                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                "locale": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Locale"
                 },
-                "secondUserId": {
+                "readAt": {
+                    "type": "string"
+                },
+                "schoolId": {
                     "type": "integer"
                 },
                 "updatedAt": {
                     "type": "string"
+                },
+                "userKind": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.ChannelCreate": {
+        "github_com_esgi-challenge_backend_internal_models.InformationsReceipt": {
             "type": "object",
             "properties": {
-                "firstUserId": {
+                "acknowledgedAt": {
+                    "type": "string"
+                },
+                "informationsId": {
                     "type": "integer"
                 },
-                "secondUserId": {
+                "readAt": {
+                    "type": "string"
+                },
+                "userId": {
                     "type": "integer"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.Class": {
+        "github_com_esgi-challenge_backend_internal_models.InformationsReceipts": {
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "type": "string"
+                "acknowledged": {
+                    "type": "integer"
                 },
-                "deletedAt": {
-                    "$ref": "#/definitions/gorm.DeletedAt"
+                "audience": {
+                    "type": "integer"
                 },
-                "id": {
+                "pending": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsPendingUser"
+                    }
+                },
+                "read": {
                     "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.InformationsRevision": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
                 },
-                "name": {
+                "description": {
                     "type": "string"
                 },
-                "pathId": {
+                "descriptionHtml": {
+                    "type": "string"
+                },
+                "editorId": {
                     "type": "integer"
                 },
-                "schoolId": {
+                "id": {
                     "type": "integer"
                 },
-                "students": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
-                    }
+                "informationsId": {
+                    "type": "integer"
                 },
-                "updatedAt": {
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.ClassAdd": {
+        "github_com_esgi-challenge_backend_internal_models.InformationsTarget": {
             "type": "object",
-            "required": [
-                "userId"
-            ],
             "properties": {
-                "userId": {
-                    "description": "Do like this to allow zero value",
+                "kind": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsTargetKind"
+                },
+                "targetId": {
                     "type": "integer"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.ClassCreate": {
+        "github_com_esgi-challenge_backend_internal_models.InformationsTargetCreate": {
             "type": "object",
             "required": [
-                "name",
-                "pathId"
+                "kind",
+                "targetId"
             ],
             "properties": {
-                "name": {
-                    "type": "string"
+                "kind": {
+                    "enum": [
+                        "class",
+                        "path",
+                        "campus",
+                        "userKind"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.InformationsTargetKind"
+                        }
+                    ]
                 },
-                "pathId": {
+                "targetId": {
                     "type": "integer"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.ClassRemove": {
+        "github_com_esgi-challenge_backend_internal_models.InformationsTargetKind": {
+            "type": "string",
+            "enum": [
+                "class",
+                "path",
+                "campus",
+                "userKind"
+            ],
+            "x-enum-varnames": [
+                "INFORMATIONS_TARGET_CLASS",
+                "INFORMATIONS_TARGET_PATH",
+                "INFORMATIONS_TARGET_CAMPUS",
+                "INFORMATIONS_TARGET_USER_KIND"
+            ]
+        },
+        "github_com_esgi-challenge_backend_internal_models.InformationsUpdate": {
             "type": "object",
             "required": [
-                "userId"
+                "description",
+                "title"
             ],
             "properties": {
-                "userId": {
+                "description": {
+                    "type": "string"
+                },
+                "documentId": {
+                    "type": "integer"
+                },
+                "expiresAt": {
+                    "description": "Unix timestamp, never expires when not set",
                     "type": "integer"
+                },
+                "pinned": {
+                    "type": "boolean"
+                },
+                "requiresAck": {
+                    "type": "boolean"
+                },
+                "title": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.ClassUpdate": {
-            "type": "object",
-            "required": [
-                "name",
-                "pathId"
+        "github_com_esgi-challenge_backend_internal_models.Locale": {
+            "type": "string",
+            "enum": [
+                "fr",
+                "en",
+                "fr"
             ],
+            "x-enum-varnames": [
+                "LOCALE_FR",
+                "LOCALE_EN",
+                "DefaultLocale"
+            ]
+        },
+        "github_com_esgi-challenge_backend_internal_models.LocationInput": {
+            "type": "object",
             "properties": {
-                "name": {
+                "input": {
                     "type": "string"
-                },
-                "pathId": {
-                    "type": "integer"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.Course": {
+        "github_com_esgi-challenge_backend_internal_models.Message": {
             "type": "object",
             "properties": {
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageAttachment"
+                    }
+                },
+                "channelId": {
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string"
+                },
                 "createdAt": {
                     "type": "string"
                 },
                 "deletedAt": {
                     "$ref": "#/definitions/gorm.DeletedAt"
                 },
-                "description": {
+                "editedAt": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "name": {
+                "removedAt": {
+                    "description": "Deleted by the sender, the message stays in the history as a tombstone",
                     "type": "string"
                 },
-                "path": {
-                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Path"
-                },
-                "pathId": {
-                    "type": "integer"
-                },
-                "schoolId": {
-                    "type": "integer"
-                },
-                "teacher": {
+                "sender": {
                     "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
                 },
-                "teacherId": {
+                "senderId": {
                     "type": "integer"
                 },
                 "updatedAt": {
@@ -3237,57 +6427,14 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.CourseCreate": {
-            "type": "object",
-            "required": [
-                "description",
-                "name",
-                "pathId",
-                "teacherId"
-            ],
-            "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "pathId": {
-                    "type": "integer"
-                },
-                "teacherId": {
-                    "type": "integer"
-                }
-            }
-        },
-        "github_com_esgi-challenge_backend_internal_models.CourseUpdate": {
+        "github_com_esgi-challenge_backend_internal_models.MessageAttachment": {
             "type": "object",
-            "required": [
-                "description",
-                "name",
-                "pathId",
-                "teacherId"
-            ],
             "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "pathId": {
-                    "type": "integer"
-                },
-                "teacherId": {
+                "channelId": {
                     "type": "integer"
-                }
-            }
-        },
-        "github_com_esgi-challenge_backend_internal_models.Document": {
-            "type": "object",
-            "properties": {
-                "course": {
-                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Course"
+                },
+                "contentType": {
+                    "type": "string"
                 },
                 "createdAt": {
                     "type": "string"
@@ -3295,44 +6442,59 @@ const docTemplate = `{
                 "deletedAt": {
                     "$ref": "#/definitions/gorm.DeletedAt"
                 },
+                "hasThumbnail": {
+                    "description": "Images have a thumbnail served by the thumbnail route",
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "messageId": {
+                    "type": "integer"
                 },
-                "path": {
+                "name": {
                     "type": "string"
                 },
-                "schoolId": {
+                "size": {
                     "type": "integer"
                 },
                 "updatedAt": {
                     "type": "string"
                 },
-                "userId": {
+                "uploaderId": {
                     "type": "integer"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.DocumentCreate": {
+        "github_com_esgi-challenge_backend_internal_models.MessageHistory": {
             "type": "object",
             "properties": {
-                "byte": {
+                "message": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Message"
+                },
+                "revisions": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageRevision"
+                    }
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.MessagePage": {
+            "type": "object",
+            "properties": {
+                "messages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Message"
                     }
                 },
-                "courseId": {
+                "nextCursor": {
                     "type": "integer"
-                },
-                "name": {
-                    "type": "string"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.Informations": {
+        "github_com_esgi-challenge_backend_internal_models.MessageReport": {
             "type": "object",
             "properties": {
                 "createdAt": {
@@ -3341,72 +6503,178 @@ const docTemplate = `{
                 "deletedAt": {
                     "$ref": "#/definitions/gorm.DeletedAt"
                 },
-                "description": {
+                "id": {
+                    "type": "integer"
+                },
+                "message": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Message"
+                },
+                "messageId": {
+                    "type": "integer"
+                },
+                "reason": {
                     "type": "string"
                 },
-                "id": {
+                "reporter": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                },
+                "reporterId": {
+                    "type": "integer"
+                },
+                "reviewedAt": {
+                    "type": "string"
+                },
+                "reviewerId": {
                     "type": "integer"
                 },
                 "schoolId": {
                     "type": "integer"
                 },
-                "title": {
-                    "type": "string"
+                "status": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageReportStatus"
                 },
                 "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.InformationsCreate": {
+        "github_com_esgi-challenge_backend_internal_models.MessageReportCreate": {
             "type": "object",
             "required": [
-                "description",
-                "title"
+                "reason"
             ],
             "properties": {
-                "description": {
+                "reason": {
+                    "type": "string",
+                    "maxLength": 1024,
+                    "minLength": 1
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.MessageReportReview": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "deleteMessage": {
+                    "description": "Replace the reported message by a tombstone",
+                    "type": "boolean"
+                },
+                "status": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageReportStatus"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.MessageReportStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "dismissed",
+                "actioned"
+            ],
+            "x-enum-varnames": [
+                "MESSAGE_REPORT_PENDING",
+                "MESSAGE_REPORT_DISMISSED",
+                "MESSAGE_REPORT_ACTIONED"
+            ]
+        },
+        "github_com_esgi-challenge_backend_internal_models.MessageRevision": {
+            "type": "object",
+            "properties": {
+                "content": {
                     "type": "string"
                 },
-                "title": {
+                "createdAt": {
                     "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "messageId": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.LocationInput": {
+        "github_com_esgi-challenge_backend_internal_models.MessageSearchPage": {
             "type": "object",
             "properties": {
-                "input": {
-                    "type": "string"
+                "nextCursor": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageSearchResult"
+                    }
                 }
             }
         },
-        "github_com_esgi-challenge_backend_internal_models.Message": {
+        "github_com_esgi-challenge_backend_internal_models.MessageSearchResult": {
             "type": "object",
             "properties": {
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.MessageAttachment"
+                    }
+                },
                 "channelId": {
                     "type": "integer"
                 },
+                "channelKind": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ChannelKind"
+                },
+                "channelName": {
+                    "type": "string"
+                },
                 "content": {
                     "type": "string"
                 },
                 "createdAt": {
                     "type": "string"
                 },
+                "cursor": {
+                    "type": "integer"
+                },
                 "deletedAt": {
                     "$ref": "#/definitions/gorm.DeletedAt"
                 },
+                "editedAt": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
+                "removedAt": {
+                    "description": "Deleted by the sender, the message stays in the history as a tombstone",
+                    "type": "string"
+                },
+                "sender": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                },
                 "senderId": {
                     "type": "integer"
                 },
+                "snippet": {
+                    "type": "string"
+                },
                 "updatedAt": {
                     "type": "string"
                 }
             }
         },
+        "github_com_esgi-challenge_backend_internal_models.MessageUpdate": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_esgi-challenge_backend_internal_models.Note": {
             "type": "object",
             "properties": {
@@ -3483,6 +6751,166 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_esgi-challenge_backend_internal_models.Notification": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "messageKey": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.NotificationMessageKey"
+                },
+                "messageParams": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "readAt": {
+                    "type": "string"
+                },
+                "refId": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.NotificationType"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.NotificationMessageKey": {
+            "type": "string",
+            "enum": [
+                "note.created",
+                "note.updated",
+                "schedule.created",
+                "schedule.updated",
+                "schedule.cancelled",
+                "project.created",
+                "informations.published",
+                "informations.reminder",
+                "event.created",
+                "event.promoted",
+                "chat.message",
+                "chat.attachment"
+            ],
+            "x-enum-varnames": [
+                "NOTIFICATION_MESSAGE_NOTE_CREATED",
+                "NOTIFICATION_MESSAGE_NOTE_UPDATED",
+                "NOTIFICATION_MESSAGE_SCHEDULE_CREATED",
+                "NOTIFICATION_MESSAGE_SCHEDULE_UPDATED",
+                "NOTIFICATION_MESSAGE_SCHEDULE_CANCELLED",
+                "NOTIFICATION_MESSAGE_PROJECT_CREATED",
+                "NOTIFICATION_MESSAGE_INFORMATIONS",
+                "NOTIFICATION_MESSAGE_INFORMATIONS_REMINDER",
+                "NOTIFICATION_MESSAGE_EVENT_CREATED",
+                "NOTIFICATION_MESSAGE_EVENT_PROMOTED",
+                "NOTIFICATION_MESSAGE_CHAT_MESSAGE",
+                "NOTIFICATION_MESSAGE_CHAT_ATTACHMENT"
+            ]
+        },
+        "github_com_esgi-challenge_backend_internal_models.NotificationPage": {
+            "type": "object",
+            "properties": {
+                "nextCursor": {
+                    "type": "integer"
+                },
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Notification"
+                    }
+                },
+                "unread": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.NotificationPreference": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EmailFrequency"
+                },
+                "inApp": {
+                    "type": "boolean"
+                },
+                "push": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.NotificationType"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.NotificationPreferenceUpdate": {
+            "type": "object",
+            "required": [
+                "email",
+                "inApp",
+                "push"
+            ],
+            "properties": {
+                "email": {
+                    "enum": [
+                        "never",
+                        "instant",
+                        "daily",
+                        "weekly"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.EmailFrequency"
+                        }
+                    ]
+                },
+                "inApp": {
+                    "type": "boolean"
+                },
+                "push": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.NotificationType": {
+            "type": "string",
+            "enum": [
+                "note",
+                "schedule",
+                "informations",
+                "project",
+                "event",
+                "chat"
+            ],
+            "x-enum-varnames": [
+                "NOTIFICATION_NOTE",
+                "NOTIFICATION_SCHEDULE",
+                "NOTIFICATION_INFORMATIONS",
+                "NOTIFICATION_PROJECT",
+                "NOTIFICATION_EVENT",
+                "NOTIFICATION_CHAT"
+            ]
+        },
         "github_com_esgi-challenge_backend_internal_models.Path": {
             "type": "object",
             "properties": {
@@ -3601,7 +7029,7 @@ const docTemplate = `{
                     "type": "integer"
                 },
                 "endDate": {
-                    "type": "string"
+                    "type": "integer"
                 },
                 "title": {
                     "type": "string",
@@ -3671,6 +7099,70 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_esgi-challenge_backend_internal_models.PushDevice": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "platform": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.PushPlatform"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.PushDeviceCreate": {
+            "type": "object",
+            "required": [
+                "platform",
+                "token"
+            ],
+            "properties": {
+                "platform": {
+                    "enum": [
+                        "android",
+                        "ios",
+                        "web"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.PushPlatform"
+                        }
+                    ]
+                },
+                "token": {
+                    "type": "string",
+                    "maxLength": 4096
+                }
+            }
+        },
+        "github_com_esgi-challenge_backend_internal_models.PushPlatform": {
+            "type": "string",
+            "enum": [
+                "android",
+                "ios",
+                "web"
+            ],
+            "x-enum-varnames": [
+                "PUSH_ANDROID",
+                "PUSH_IOS",
+                "PUSH_WEB"
+            ]
+        },
         "github_com_esgi-challenge_backend_internal_models.Schedule": {
             "type": "object",
             "properties": {
@@ -3840,6 +7332,18 @@ const docTemplate = `{
                     "maxLength": 128,
                     "minLength": 1
                 },
+                "locale": {
+                    "description": "Language of the invitation and the next emails, french when not set",
+                    "enum": [
+                        "fr",
+                        "en"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Locale"
+                        }
+                    ]
+                },
                 "type": {
                     "type": "string"
                 }
@@ -3876,6 +7380,20 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_esgi-challenge_backend_internal_models.UnreadSummary": {
+            "type": "object",
+            "properties": {
+                "channels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.ChannelUnread"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
         "github_com_esgi-challenge_backend_internal_models.UpdateMe": {
             "type": "object",
             "properties": {
@@ -3887,6 +7405,18 @@ const docTemplate = `{
                 },
                 "lastname": {
                     "type": "string"
+                },
+                "locale": {
+                    "description": "The current locale is kept when not set",
+                    "enum": [
+                        "fr",
+                        "en"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Locale"
+                        }
+                    ]
                 }
             }
         },
@@ -3928,6 +7458,9 @@ const docTemplate = `{
                 "lastname": {
                     "type": "string"
                 },
+                "locale": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.Locale"
+                },
                 "schoolId": {
                     "type": "integer"
                 },
@@ -3939,6 +7472,23 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_esgi-challenge_backend_internal_models.UserBlock": {
+            "type": "object",
+            "properties": {
+                "blocked": {
+                    "$ref": "#/definitions/github_com_esgi-challenge_backend_internal_models.User"
+                },
+                "blockedId": {
+                    "type": "integer"
+                },
+                "blockerId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_esgi-challenge_backend_internal_models.UserCreate": {
             "type": "object",
             "properties": {
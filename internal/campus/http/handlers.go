@@ -110,6 +110,7 @@ func (u *campusHandlers) Create() gin.HandlerFunc {
 			SchoolId:  school.ID,
 			Latitude:  campusCreate.Latitude,
 			Longitude: campusCreate.Longitude,
+			Timezone:  campusCreate.Timezone,
 		}
 
 		campusDb, err := u.campusUseCase.Create(user, campus)
@@ -261,6 +262,7 @@ func (u *campusHandlers) Update() gin.HandlerFunc {
 			Latitude:  campusUpdate.Latitude,
 			Longitude: campusUpdate.Longitude,
 			SchoolId:  campusDb.SchoolId,
+			Timezone:  campusUpdate.Timezone,
 		}
 		updatedCampus, err := u.campusUseCase.Update(user, uint(idInt), campus)
 
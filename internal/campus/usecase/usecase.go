@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/esgi-challenge/backend/config"
 	"github.com/esgi-challenge/backend/internal/campus"
@@ -22,6 +23,23 @@ func NewCampusUseCase(cfg *config.Config, campusRepo campus.Repository, schoolRe
 	return &campusUseCase{cfg: cfg, campusRepo: campusRepo, schoolRepo: schoolRepo, logger: logger}
 }
 
+func checkTimezone(campus *models.Campus) error {
+	if campus.Timezone == "" {
+		campus.Timezone = models.DefaultTimezone
+		return nil
+	}
+
+	// Local would be the timezone of the server
+	if _, err := time.LoadLocation(campus.Timezone); err != nil || campus.Timezone == "Local" {
+		return errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "Unknown timezone",
+		}
+	}
+
+	return nil
+}
+
 func (u *campusUseCase) Create(user *models.User, campus *models.Campus) (*models.Campus, error) {
 	school, err := u.schoolRepo.GetById(campus.SchoolId)
 
@@ -36,6 +54,10 @@ func (u *campusUseCase) Create(user *models.User, campus *models.Campus) (*model
 		}
 	}
 
+	if err := checkTimezone(campus); err != nil {
+		return nil, err
+	}
+
 	return u.campusRepo.Create(campus)
 }
 
@@ -65,6 +87,10 @@ func (u *campusUseCase) Update(user *models.User, id uint, updatedCampus *models
 		}
 	}
 
+	if err := checkTimezone(updatedCampus); err != nil {
+		return nil, err
+	}
+
 	// Temporary fix for known issue :
 	// https://github.com/go-gorm/gorm/issues/5724
 	//////////////////////////////////////
@@ -37,6 +37,22 @@ func TestCreateCampus(t *testing.T) {
 		createdCampus, err := useCase.Create(user, campus)
 		assert.NoError(t, err)
 		assert.Equal(t, campus, createdCampus)
+		assert.Equal(t, models.DefaultTimezone, createdCampus.Timezone)
+	})
+
+	t.Run("unknown timezone", func(t *testing.T) {
+		user := &models.User{GormModel: models.GormModel{ID: 1}}
+		school := &models.School{GormModel: models.GormModel{ID: 1}, UserID: 1}
+		campus := &models.Campus{SchoolId: 1, Timezone: "Europe/Nowhere"}
+
+		mockSchoolRepo.EXPECT().GetById(uint(1)).Return(school, nil)
+
+		createdCampus, err := useCase.Create(user, campus)
+		assert.Nil(t, createdCampus)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "Unknown timezone",
+		}, err)
 	})
 
 	t.Run("school not owned by user", func(t *testing.T) {
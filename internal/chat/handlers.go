@@ -8,6 +8,25 @@ type Handlers interface {
 	Create() gin.HandlerFunc
 	GetAll() gin.HandlerFunc
 	GetById() gin.HandlerFunc
+	GetMessages() gin.HandlerFunc
+	SearchMessages() gin.HandlerFunc
+	UploadAttachment() gin.HandlerFunc
+	GetAttachment() gin.HandlerFunc
+	GetAttachmentThumbnail() gin.HandlerFunc
+	EditMessage() gin.HandlerFunc
+	DeleteMessage() gin.HandlerFunc
+	GetMessageHistory() gin.HandlerFunc
+	ReportMessage() gin.HandlerFunc
+	GetReports() gin.HandlerFunc
+	ReviewReport() gin.HandlerFunc
+	BlockUser() gin.HandlerFunc
+	UnblockUser() gin.HandlerFunc
+	GetBlockedUsers() gin.HandlerFunc
+	MuteUser() gin.HandlerFunc
+	GetMutes() gin.HandlerFunc
+	Unmute() gin.HandlerFunc
+	MarkRead() gin.HandlerFunc
+	GetUnread() gin.HandlerFunc
 	GetAllStudentChatter() gin.HandlerFunc
 	GetAllTeacherChatter() gin.HandlerFunc
 }
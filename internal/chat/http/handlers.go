@@ -1,6 +1,8 @@
 package http
 
 import (
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
@@ -54,8 +56,8 @@ func (u *chatHandlers) Create() gin.HandlerFunc {
 		}
 
 		channel := &models.Channel{
-			FirstUserId:  channelCreate.FirstUserId,
-			SecondUserId: channelCreate.SecondUserId,
+			FirstUserId:  &channelCreate.FirstUserId,
+			SecondUserId: &channelCreate.SecondUserId,
 		}
 		channelDb, err := u.chatUseCase.Create(channel)
 
@@ -72,7 +74,7 @@ func (u *chatHandlers) Create() gin.HandlerFunc {
 // Read
 //
 //	@Summary		Get all channels
-//	@Description	Get all channels
+//	@Description	Get the direct and group channels the user is a member of, with their last message and unread count
 //	@Tags			Chat
 //	@Produce		json
 //	@Success		200	{object}	[]models.Channel
@@ -168,6 +170,7 @@ func (u *chatHandlers) GetAllTeacherChatter() gin.HandlerFunc {
 //	@Param			id	path		int	true	"id"
 //	@Success		200	{object}	models.Channel
 //	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		403	{object}	errorHandler.HttpErr
 //	@Failure		404	{object}	errorHandler.HttpErr
 //	@Failure		500	{object}	errorHandler.HttpErr
 //	@Router			/chats/channel/{id} [get]
@@ -189,7 +192,7 @@ func (u *chatHandlers) GetById() gin.HandlerFunc {
 			return
 		}
 
-		channel, err := u.chatUseCase.GetById(uint(idInt))
+		channel, err := u.chatUseCase.GetById(user, uint(idInt))
 
 		if err != nil {
 			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
@@ -200,3 +203,513 @@ func (u *chatHandlers) GetById() gin.HandlerFunc {
 		ctx.JSON(http.StatusOK, channel)
 	}
 }
+
+// Read messages
+//
+//	@Summary		Get channel messages
+//	@Description	Get a page of the channel history in chronological order, nextCursor is given as before to load older messages
+//	@Tags			Chat
+//	@Produce		json
+//	@Param			id		path		int	true	"id"
+//	@Param			before	query		int	false	"Cursor, only messages older than this message id"
+//	@Param			limit	query		int	false	"Page size, 50 by default and 100 at most"
+//	@Success		200		{object}	models.MessagePage
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		403		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/chats/channel/{id}/messages [get]
+func (u *chatHandlers) GetMessages() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var before *uint
+
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		if beforeStr := ctx.Query("before"); beforeStr != "" {
+			beforeInt, err := strconv.ParseUint(beforeStr, 10, 32)
+
+			if err != nil {
+				ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+				u.logger.Infof("Request: %v", err.Error())
+				return
+			}
+
+			tmp := uint(beforeInt)
+			before = &tmp
+		}
+
+		limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		page, err := u.chatUseCase.GetMessages(user, uint(idInt), before, limit)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, page)
+	}
+}
+
+// Create attachment
+//
+//	@Summary		Upload message attachment
+//	@Description	Upload a file to the channel, its id is then sent in the attachmentIds of a message. Images get a thumbnail
+//	@Tags			Chat
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Param			id		path		int		true	"id"
+//	@Param			file	formData	file	true	"File"
+//	@Param			name	formData	string	false	"File name, the uploaded file name by default"
+//	@Success		201		{object}	models.MessageAttachment
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		403		{object}	errorHandler.HttpErr
+//	@Failure		413		{object}	errorHandler.HttpErr
+//	@Failure		415		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/chats/channel/{id}/attachments [post]
+func (u *chatHandlers) UploadAttachment() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		file, header, err := ctx.Request.FormFile("file")
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+		defer file.Close()
+
+		if header.Size > models.MessageAttachmentMaxSize {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(errorHandler.HttpError{
+				HttpStatus: http.StatusRequestEntityTooLarge,
+				HttpError:  "The file is too large",
+			}))
+			return
+		}
+
+		// The declared size can't be trusted, at most one byte over the limit is read
+		bs, err := io.ReadAll(io.LimitReader(file, models.MessageAttachmentMaxSize+1))
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.InternalServerErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		if len(bs) > models.MessageAttachmentMaxSize {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(errorHandler.HttpError{
+				HttpStatus: http.StatusRequestEntityTooLarge,
+				HttpError:  "The file is too large",
+			}))
+			return
+		}
+
+		name := ctx.Request.FormValue("name")
+		if name == "" {
+			name = header.Filename
+		}
+
+		attachment, err := u.chatUseCase.UploadAttachment(user, uint(idInt), &models.MessageAttachmentCreate{
+			Name: name,
+			Byte: bs,
+		})
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, attachment)
+	}
+}
+
+// Read attachment
+//
+//	@Summary		Download message attachment
+//	@Description	Download an attachment, only for the members of its channel
+//	@Tags			Chat
+//	@Produce		octet-stream
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{file}		file
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		403	{object}	errorHandler.HttpErr
+//	@Failure		404	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/chats/attachments/{id} [get]
+func (u *chatHandlers) GetAttachment() gin.HandlerFunc {
+	return u.serveAttachment(false)
+}
+
+// Read attachment thumbnail
+//
+//	@Summary		Get message attachment thumbnail
+//	@Description	Get the JPEG thumbnail of an image attachment, only for the members of its channel
+//	@Tags			Chat
+//	@Produce		jpeg
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{file}		file
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		403	{object}	errorHandler.HttpErr
+//	@Failure		404	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/chats/attachments/{id}/thumbnail [get]
+func (u *chatHandlers) GetAttachmentThumbnail() gin.HandlerFunc {
+	return u.serveAttachment(true)
+}
+
+func (u *chatHandlers) serveAttachment(thumbnail bool) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		attachment, file, err := u.chatUseCase.GetAttachment(user, uint(idInt), thumbnail)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		if thumbnail {
+			ctx.Data(http.StatusOK, "image/jpeg", file)
+			return
+		}
+
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", attachment.Name))
+		ctx.Data(http.StatusOK, attachment.ContentType, file)
+	}
+}
+
+// Update message
+//
+//	@Summary		Edit message
+//	@Description	Edit a message sent by the user during the edit window, an edit event is sent to the channel sockets
+//	@Tags			Chat
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int						true	"id"
+//	@Param			message	body		models.MessageUpdate	true	"Message content"
+//	@Success		200		{object}	models.Message
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		403		{object}	errorHandler.HttpErr
+//	@Failure		404		{object}	errorHandler.HttpErr
+//	@Failure		409		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/chats/messages/{id} [put]
+func (u *chatHandlers) EditMessage() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		var body models.MessageUpdate
+
+		messageUpdate, err := request.ValidateJSON(body, ctx)
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		message, err := u.chatUseCase.EditMessage(user, uint(idInt), messageUpdate.Content)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, message)
+	}
+}
+
+// Delete message
+//
+//	@Summary		Delete message
+//	@Description	Replace a message sent by the user during the edit window by a tombstone, a delete event is sent to the channel sockets
+//	@Tags			Chat
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{object}	models.Message
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		403	{object}	errorHandler.HttpErr
+//	@Failure		404	{object}	errorHandler.HttpErr
+//	@Failure		409	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/chats/messages/{id} [delete]
+func (u *chatHandlers) DeleteMessage() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		message, err := u.chatUseCase.DeleteMessage(user, uint(idInt))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, message)
+	}
+}
+
+// Read message history
+//
+//	@Summary		Get message history
+//	@Description	Get a message with its previous contents, for the administrators of the sender school
+//	@Tags			Chat
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{object}	models.MessageHistory
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		403	{object}	errorHandler.HttpErr
+//	@Failure		404	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/chats/messages/{id}/history [get]
+func (u *chatHandlers) GetMessageHistory() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.ADMINISTRATOR)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		history, err := u.chatUseCase.GetMessageHistory(user, uint(idInt))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, history)
+	}
+}
+
+// Search messages
+//
+//	@Summary		Search messages
+//	@Description	Full text search in the messages of the user channels, newest first. Matches are highlighted with <mark> tags in the snippets and the cursor of a result is given as before to the channel history to jump to it
+//	@Tags			Chat
+//	@Produce		json
+//	@Param			q		query		string	true	"Search query"
+//	@Param			before	query		int		false	"Cursor, only messages older than this message id"
+//	@Param			limit	query		int		false	"Page size, 50 by default and 100 at most"
+//	@Success		200		{object}	models.MessageSearchPage
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/chats/search [get]
+func (u *chatHandlers) SearchMessages() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var before *uint
+
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		if beforeStr := ctx.Query("before"); beforeStr != "" {
+			beforeInt, err := strconv.ParseUint(beforeStr, 10, 32)
+
+			if err != nil {
+				ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+				u.logger.Infof("Request: %v", err.Error())
+				return
+			}
+
+			tmp := uint(beforeInt)
+			before = &tmp
+		}
+
+		limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		page, err := u.chatUseCase.SearchMessages(user, ctx.Query("q"), before, limit)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, page)
+	}
+}
+
+// Read
+//
+//	@Summary		Mark channel as read
+//	@Description	Mark the channel read up to the given message, or up to its latest message without body. A read event is sent to the channel sockets
+//	@Tags			Chat
+//	@Accept			json
+//	@Param			id		path	int					true	"id"
+//	@Param			read	body	models.ChannelRead	false	"Last read message"
+//	@Success		204
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		403	{object}	errorHandler.HttpErr
+//	@Failure		404	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/chats/channel/{id}/read [post]
+func (u *chatHandlers) MarkRead() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		var body models.ChannelRead
+
+		// The body is optional
+		if ctx.Request.ContentLength != 0 {
+			body, err = request.ValidateJSON(body, ctx)
+
+			if err != nil {
+				ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+				u.logger.Infof("Request: %v", err.Error())
+				return
+			}
+		}
+
+		err = u.chatUseCase.MarkRead(user, uint(idInt), &body)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
+// Read unread
+//
+//	@Summary		Get unread counts
+//	@Description	Get the number of unread messages per channel and in total
+//	@Tags			Chat
+//	@Produce		json
+//	@Success		200	{object}	models.UnreadSummary
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/chats/unread [get]
+func (u *chatHandlers) GetUnread() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		summary, err := u.chatUseCase.GetUnread(user)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, summary)
+	}
+}
@@ -0,0 +1,381 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/request"
+	"github.com/gin-gonic/gin"
+)
+
+// Create report
+//
+//	@Summary		Report message
+//	@Description	Report a message of one of the user channels to the school administrators
+//	@Tags			Chat
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"id"
+//	@Param			report	body		models.MessageReportCreate	true	"Report reason"
+//	@Success		201		{object}	models.MessageReport
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		403		{object}	errorHandler.HttpErr
+//	@Failure		404		{object}	errorHandler.HttpErr
+//	@Failure		409		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/chats/messages/{id}/report [post]
+func (u *chatHandlers) ReportMessage() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		var body models.MessageReportCreate
+
+		reportCreate, err := request.ValidateJSON(body, ctx)
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		report, err := u.chatUseCase.ReportMessage(user, uint(idInt), &reportCreate)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, report)
+	}
+}
+
+// Read reports
+//
+//	@Summary		Get message reports
+//	@Description	Moderation queue of the administrator school, newest first
+//	@Tags			Chat
+//	@Produce		json
+//	@Param			status	query		string	false	"pending, dismissed or actioned, every status by default"
+//	@Success		200		{object}	[]models.MessageReport
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/chats/reports [get]
+func (u *chatHandlers) GetReports() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.ADMINISTRATOR)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		reports, err := u.chatUseCase.GetReports(user, models.MessageReportStatus(ctx.Query("status")))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, reports)
+	}
+}
+
+// Update report
+//
+//	@Summary		Review message report
+//	@Description	Dismiss or action a report, the reported message can be deleted at the same time
+//	@Tags			Chat
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int							true	"id"
+//	@Param			review	body		models.MessageReportReview	true	"Review"
+//	@Success		200		{object}	models.MessageReport
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		403		{object}	errorHandler.HttpErr
+//	@Failure		404		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/chats/reports/{id} [put]
+func (u *chatHandlers) ReviewReport() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.ADMINISTRATOR)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		var body models.MessageReportReview
+
+		review, err := request.ValidateJSON(body, ctx)
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		report, err := u.chatUseCase.ReviewReport(user, uint(idInt), &review)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, report)
+	}
+}
+
+// Read blocks
+//
+//	@Summary		Get blocked users
+//	@Description	Get the users blocked by the user
+//	@Tags			Chat
+//	@Produce		json
+//	@Success		200	{object}	[]models.UserBlock
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/chats/blocks [get]
+func (u *chatHandlers) GetBlockedUsers() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		blocks, err := u.chatUseCase.GetBlockedUsers(user)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, blocks)
+	}
+}
+
+// Create block
+//
+//	@Summary		Block user
+//	@Description	The blocked user can't open a direct channel with the user nor message it
+//	@Tags			Chat
+//	@Produce		json
+//	@Param			userId	path		int	true	"Blocked user id"
+//	@Success		200		{object}	nil
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		404		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/chats/blocks/{userId} [post]
+func (u *chatHandlers) BlockUser() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("userId")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		err = u.chatUseCase.BlockUser(user, uint(idInt))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, nil)
+	}
+}
+
+// Delete block
+//
+//	@Summary		Unblock user
+//	@Description	Unblock user
+//	@Tags			Chat
+//	@Produce		json
+//	@Param			userId	path		int	true	"Blocked user id"
+//	@Success		200		{object}	nil
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/chats/blocks/{userId} [delete]
+func (u *chatHandlers) UnblockUser() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("userId")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		err = u.chatUseCase.UnblockUser(user, uint(idInt))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, nil)
+	}
+}
+
+// Read mutes
+//
+//	@Summary		Get chat mutes
+//	@Description	Get the mutes not over yet of the administrator school
+//	@Tags			Chat
+//	@Produce		json
+//	@Success		200	{object}	[]models.ChatMute
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/chats/mutes [get]
+func (u *chatHandlers) GetMutes() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.ADMINISTRATOR)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		mutes, err := u.chatUseCase.GetMutes(user)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, mutes)
+	}
+}
+
+// Create mute
+//
+//	@Summary		Mute user
+//	@Description	The muted user can't send, edit or attach anything in chat until the given date
+//	@Tags			Chat
+//	@Accept			json
+//	@Produce		json
+//	@Param			mute	body		models.ChatMuteCreate	true	"Mute infos"
+//	@Success		201		{object}	models.ChatMute
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		403		{object}	errorHandler.HttpErr
+//	@Failure		404		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/chats/mutes [post]
+func (u *chatHandlers) MuteUser() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.ADMINISTRATOR)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		var body models.ChatMuteCreate
+
+		muteCreate, err := request.ValidateJSON(body, ctx)
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		mute, err := u.chatUseCase.MuteUser(user, &muteCreate)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, mute)
+	}
+}
+
+// Delete mute
+//
+//	@Summary		Unmute user
+//	@Description	End a mute before its date
+//	@Tags			Chat
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{object}	nil
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		403	{object}	errorHandler.HttpErr
+//	@Failure		404	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/chats/mutes/{id} [delete]
+func (u *chatHandlers) Unmute() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.ADMINISTRATOR)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		err = u.chatUseCase.Unmute(user, uint(idInt))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, nil)
+	}
+}
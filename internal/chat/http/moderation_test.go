@@ -0,0 +1,116 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/chat/mock"
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/jwt"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReviewReport(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewLogger()
+	logger.InitLogger()
+	mockUseCase := mock.NewMockUseCase(ctrl)
+	cfg := &config.Config{JwtSecret: "secret"}
+	handlers := NewChatHandlers(cfg, mockUseCase, logger)
+
+	review := &models.MessageReportReview{
+		Status:        models.MESSAGE_REPORT_ACTIONED,
+		DeleteMessage: true,
+	}
+
+	body, err := json.Marshal(review)
+	if err != nil {
+		t.Fatalf("Failed to marshal JSON: %v", err)
+	}
+
+	adminUser := &models.User{UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+	token, _ := jwt.Generate(cfg.JwtSecret, adminUser)
+
+	studentUser := &models.User{UserKind: models.NewUserKind(models.STUDENT)}
+	studentToken, _ := jwt.Generate(cfg.JwtSecret, studentUser)
+
+	t.Run("ReviewReport request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/api/chats/reports/1", bytes.NewBuffer(body))
+		req.Header.Set("Content-type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		mockUseCase.EXPECT().ReviewReport(gomock.Any(), uint(1), review).Return(&models.MessageReport{Status: models.MESSAGE_REPORT_ACTIONED}, nil)
+
+		handler := handlers.ReviewReport()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("ReviewReport request not administrator", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/api/chats/reports/1", bytes.NewBuffer(body))
+		req.Header.Set("Content-type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+studentToken)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler := handlers.ReviewReport()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("ReviewReport request other school", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/api/chats/reports/1", bytes.NewBuffer(body))
+		req.Header.Set("Content-type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		mockUseCase.EXPECT().ReviewReport(gomock.Any(), uint(1), review).Return(nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This report is not from your school",
+		})
+
+		handler := handlers.ReviewReport()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusForbidden, res.Code)
+	})
+
+	t.Run("ReviewReport request bad body", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/api/chats/reports/1", bytes.NewBufferString("{}"))
+		req.Header.Set("Content-type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler := handlers.ReviewReport()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
@@ -11,4 +11,23 @@ func SetupChatRoutes(chatGroup *gin.RouterGroup, h chat.Handlers) {
 	chatGroup.GET("/students", h.GetAllStudentChatter())
 	chatGroup.GET("/teachers", h.GetAllTeacherChatter())
 	chatGroup.GET("/channel/:id", h.GetById())
+	chatGroup.GET("/channel/:id/messages", h.GetMessages())
+	chatGroup.POST("/channel/:id/read", h.MarkRead())
+	chatGroup.POST("/channel/:id/attachments", h.UploadAttachment())
+	chatGroup.GET("/attachments/:id", h.GetAttachment())
+	chatGroup.GET("/attachments/:id/thumbnail", h.GetAttachmentThumbnail())
+	chatGroup.PUT("/messages/:id", h.EditMessage())
+	chatGroup.DELETE("/messages/:id", h.DeleteMessage())
+	chatGroup.GET("/messages/:id/history", h.GetMessageHistory())
+	chatGroup.GET("/unread", h.GetUnread())
+	chatGroup.GET("/search", h.SearchMessages())
+	chatGroup.POST("/messages/:id/report", h.ReportMessage())
+	chatGroup.GET("/reports", h.GetReports())
+	chatGroup.PUT("/reports/:id", h.ReviewReport())
+	chatGroup.GET("/blocks", h.GetBlockedUsers())
+	chatGroup.POST("/blocks/:userId", h.BlockUser())
+	chatGroup.DELETE("/blocks/:userId", h.UnblockUser())
+	chatGroup.GET("/mutes", h.GetMutes())
+	chatGroup.POST("/mutes", h.MuteUser())
+	chatGroup.DELETE("/mutes/:id", h.Unmute())
 }
@@ -11,6 +11,7 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	models "github.com/esgi-challenge/backend/internal/models"
 	gomock "go.uber.org/mock/gomock"
@@ -39,6 +40,34 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddMembers mocks base method.
+func (m *MockRepository) AddMembers(channelId uint, userIds []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMembers", channelId, userIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMembers indicates an expected call of AddMembers.
+func (mr *MockRepositoryMockRecorder) AddMembers(channelId, userIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMembers", reflect.TypeOf((*MockRepository)(nil).AddMembers), channelId, userIds)
+}
+
+// Block mocks base method.
+func (m *MockRepository) Block(block *models.UserBlock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Block", block)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Block indicates an expected call of Block.
+func (mr *MockRepositoryMockRecorder) Block(block any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Block", reflect.TypeOf((*MockRepository)(nil).Block), block)
+}
+
 // Create mocks base method.
 func (m *MockRepository) Create(chat *models.Channel) (*models.Channel, error) {
 	m.ctrl.T.Helper()
@@ -54,6 +83,123 @@ func (mr *MockRepositoryMockRecorder) Create(chat any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), chat)
 }
 
+// CreateAttachment mocks base method.
+func (m *MockRepository) CreateAttachment(attachment *models.MessageAttachment) (*models.MessageAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttachment", attachment)
+	ret0, _ := ret[0].(*models.MessageAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttachment indicates an expected call of CreateAttachment.
+func (mr *MockRepositoryMockRecorder) CreateAttachment(attachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttachment", reflect.TypeOf((*MockRepository)(nil).CreateAttachment), attachment)
+}
+
+// CreateMute mocks base method.
+func (m *MockRepository) CreateMute(mute *models.ChatMute) (*models.ChatMute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMute", mute)
+	ret0, _ := ret[0].(*models.ChatMute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMute indicates an expected call of CreateMute.
+func (mr *MockRepositoryMockRecorder) CreateMute(mute any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMute", reflect.TypeOf((*MockRepository)(nil).CreateMute), mute)
+}
+
+// CreateReport mocks base method.
+func (m *MockRepository) CreateReport(report *models.MessageReport) (*models.MessageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", report)
+	ret0, _ := ret[0].(*models.MessageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockRepositoryMockRecorder) CreateReport(report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockRepository)(nil).CreateReport), report)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockRepository) DeleteAttachment(id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockRepositoryMockRecorder) DeleteAttachment(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockRepository)(nil).DeleteAttachment), id)
+}
+
+// DeleteByRef mocks base method.
+func (m *MockRepository) DeleteByRef(kind models.ChannelKind, refId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByRef", kind, refId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByRef indicates an expected call of DeleteByRef.
+func (mr *MockRepositoryMockRecorder) DeleteByRef(kind, refId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByRef", reflect.TypeOf((*MockRepository)(nil).DeleteByRef), kind, refId)
+}
+
+// DeleteMute mocks base method.
+func (m *MockRepository) DeleteMute(id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMute", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMute indicates an expected call of DeleteMute.
+func (mr *MockRepositoryMockRecorder) DeleteMute(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMute", reflect.TypeOf((*MockRepository)(nil).DeleteMute), id)
+}
+
+// GetActiveMute mocks base method.
+func (m *MockRepository) GetActiveMute(userId uint) (*models.ChatMute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveMute", userId)
+	ret0, _ := ret[0].(*models.ChatMute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveMute indicates an expected call of GetActiveMute.
+func (mr *MockRepositoryMockRecorder) GetActiveMute(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveMute", reflect.TypeOf((*MockRepository)(nil).GetActiveMute), userId)
+}
+
+// GetActiveMutes mocks base method.
+func (m *MockRepository) GetActiveMutes(schoolId *uint) (*[]models.ChatMute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveMutes", schoolId)
+	ret0, _ := ret[0].(*[]models.ChatMute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveMutes indicates an expected call of GetActiveMutes.
+func (mr *MockRepositoryMockRecorder) GetActiveMutes(schoolId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveMutes", reflect.TypeOf((*MockRepository)(nil).GetActiveMutes), schoolId)
+}
+
 // GetAllByUser mocks base method.
 func (m *MockRepository) GetAllByUser(userId uint) (*[]models.Channel, error) {
 	m.ctrl.T.Helper()
@@ -69,6 +215,66 @@ func (mr *MockRepositoryMockRecorder) GetAllByUser(userId any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUser", reflect.TypeOf((*MockRepository)(nil).GetAllByUser), userId)
 }
 
+// GetAllProjectGroups mocks base method.
+func (m *MockRepository) GetAllProjectGroups() (*[]models.ProjectStudent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllProjectGroups")
+	ret0, _ := ret[0].(*[]models.ProjectStudent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllProjectGroups indicates an expected call of GetAllProjectGroups.
+func (mr *MockRepositoryMockRecorder) GetAllProjectGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllProjectGroups", reflect.TypeOf((*MockRepository)(nil).GetAllProjectGroups))
+}
+
+// GetAttachmentById mocks base method.
+func (m *MockRepository) GetAttachmentById(id uint) (*models.MessageAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentById", id)
+	ret0, _ := ret[0].(*models.MessageAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentById indicates an expected call of GetAttachmentById.
+func (mr *MockRepositoryMockRecorder) GetAttachmentById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentById", reflect.TypeOf((*MockRepository)(nil).GetAttachmentById), id)
+}
+
+// GetAttachmentsByIds mocks base method.
+func (m *MockRepository) GetAttachmentsByIds(ids []uint) (*[]models.MessageAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachmentsByIds", ids)
+	ret0, _ := ret[0].(*[]models.MessageAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachmentsByIds indicates an expected call of GetAttachmentsByIds.
+func (mr *MockRepositoryMockRecorder) GetAttachmentsByIds(ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachmentsByIds", reflect.TypeOf((*MockRepository)(nil).GetAttachmentsByIds), ids)
+}
+
+// GetBlocks mocks base method.
+func (m *MockRepository) GetBlocks(blockerId uint) (*[]models.UserBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlocks", blockerId)
+	ret0, _ := ret[0].(*[]models.UserBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlocks indicates an expected call of GetBlocks.
+func (mr *MockRepositoryMockRecorder) GetBlocks(blockerId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocks", reflect.TypeOf((*MockRepository)(nil).GetBlocks), blockerId)
+}
+
 // GetById mocks base method.
 func (m *MockRepository) GetById(id uint) (*models.Channel, error) {
 	m.ctrl.T.Helper()
@@ -84,17 +290,420 @@ func (mr *MockRepositoryMockRecorder) GetById(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockRepository)(nil).GetById), id)
 }
 
+// GetByRef mocks base method.
+func (m *MockRepository) GetByRef(kind models.ChannelKind, refId uint, group *uint) (*models.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByRef", kind, refId, group)
+	ret0, _ := ret[0].(*models.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByRef indicates an expected call of GetByRef.
+func (mr *MockRepositoryMockRecorder) GetByRef(kind, refId, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRef", reflect.TypeOf((*MockRepository)(nil).GetByRef), kind, refId, group)
+}
+
+// GetClassMemberIds mocks base method.
+func (m *MockRepository) GetClassMemberIds(classId uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClassMemberIds", classId)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClassMemberIds indicates an expected call of GetClassMemberIds.
+func (mr *MockRepositoryMockRecorder) GetClassMemberIds(classId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClassMemberIds", reflect.TypeOf((*MockRepository)(nil).GetClassMemberIds), classId)
+}
+
+// GetCourseIdsByPath mocks base method.
+func (m *MockRepository) GetCourseIdsByPath(pathId uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCourseIdsByPath", pathId)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCourseIdsByPath indicates an expected call of GetCourseIdsByPath.
+func (mr *MockRepositoryMockRecorder) GetCourseIdsByPath(pathId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCourseIdsByPath", reflect.TypeOf((*MockRepository)(nil).GetCourseIdsByPath), pathId)
+}
+
+// GetCourseMemberIds mocks base method.
+func (m *MockRepository) GetCourseMemberIds(course *models.Course) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCourseMemberIds", course)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCourseMemberIds indicates an expected call of GetCourseMemberIds.
+func (mr *MockRepositoryMockRecorder) GetCourseMemberIds(course any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCourseMemberIds", reflect.TypeOf((*MockRepository)(nil).GetCourseMemberIds), course)
+}
+
+// GetLastMessages mocks base method.
+func (m *MockRepository) GetLastMessages(channelIds []uint) (*[]models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastMessages", channelIds)
+	ret0, _ := ret[0].(*[]models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastMessages indicates an expected call of GetLastMessages.
+func (mr *MockRepositoryMockRecorder) GetLastMessages(channelIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastMessages", reflect.TypeOf((*MockRepository)(nil).GetLastMessages), channelIds)
+}
+
+// GetMemberChannelIds mocks base method.
+func (m *MockRepository) GetMemberChannelIds(userId uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberChannelIds", userId)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberChannelIds indicates an expected call of GetMemberChannelIds.
+func (mr *MockRepositoryMockRecorder) GetMemberChannelIds(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberChannelIds", reflect.TypeOf((*MockRepository)(nil).GetMemberChannelIds), userId)
+}
+
+// GetMemberIds mocks base method.
+func (m *MockRepository) GetMemberIds(channelId uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberIds", channelId)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMemberIds indicates an expected call of GetMemberIds.
+func (mr *MockRepositoryMockRecorder) GetMemberIds(channelId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberIds", reflect.TypeOf((*MockRepository)(nil).GetMemberIds), channelId)
+}
+
+// GetMessageById mocks base method.
+func (m *MockRepository) GetMessageById(id uint) (*models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageById", id)
+	ret0, _ := ret[0].(*models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageById indicates an expected call of GetMessageById.
+func (mr *MockRepositoryMockRecorder) GetMessageById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageById", reflect.TypeOf((*MockRepository)(nil).GetMessageById), id)
+}
+
+// GetMessageRevisions mocks base method.
+func (m *MockRepository) GetMessageRevisions(messageId uint) (*[]models.MessageRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageRevisions", messageId)
+	ret0, _ := ret[0].(*[]models.MessageRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageRevisions indicates an expected call of GetMessageRevisions.
+func (mr *MockRepositoryMockRecorder) GetMessageRevisions(messageId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageRevisions", reflect.TypeOf((*MockRepository)(nil).GetMessageRevisions), messageId)
+}
+
+// GetMessageWithSender mocks base method.
+func (m *MockRepository) GetMessageWithSender(id uint) (*models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageWithSender", id)
+	ret0, _ := ret[0].(*models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageWithSender indicates an expected call of GetMessageWithSender.
+func (mr *MockRepositoryMockRecorder) GetMessageWithSender(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageWithSender", reflect.TypeOf((*MockRepository)(nil).GetMessageWithSender), id)
+}
+
+// GetMessages mocks base method.
+func (m *MockRepository) GetMessages(channelId uint, before *uint, limit int) (*[]models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessages", channelId, before, limit)
+	ret0, _ := ret[0].(*[]models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessages indicates an expected call of GetMessages.
+func (mr *MockRepositoryMockRecorder) GetMessages(channelId, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessages", reflect.TypeOf((*MockRepository)(nil).GetMessages), channelId, before, limit)
+}
+
+// GetMuteById mocks base method.
+func (m *MockRepository) GetMuteById(id uint) (*models.ChatMute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMuteById", id)
+	ret0, _ := ret[0].(*models.ChatMute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMuteById indicates an expected call of GetMuteById.
+func (mr *MockRepositoryMockRecorder) GetMuteById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMuteById", reflect.TypeOf((*MockRepository)(nil).GetMuteById), id)
+}
+
+// GetPendingAttachmentsBefore mocks base method.
+func (m *MockRepository) GetPendingAttachmentsBefore(date time.Time) (*[]models.MessageAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingAttachmentsBefore", date)
+	ret0, _ := ret[0].(*[]models.MessageAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingAttachmentsBefore indicates an expected call of GetPendingAttachmentsBefore.
+func (mr *MockRepositoryMockRecorder) GetPendingAttachmentsBefore(date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingAttachmentsBefore", reflect.TypeOf((*MockRepository)(nil).GetPendingAttachmentsBefore), date)
+}
+
+// GetProjectGroupMemberIds mocks base method.
+func (m *MockRepository) GetProjectGroupMemberIds(projectId, group uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProjectGroupMemberIds", projectId, group)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProjectGroupMemberIds indicates an expected call of GetProjectGroupMemberIds.
+func (mr *MockRepositoryMockRecorder) GetProjectGroupMemberIds(projectId, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectGroupMemberIds", reflect.TypeOf((*MockRepository)(nil).GetProjectGroupMemberIds), projectId, group)
+}
+
+// GetReportById mocks base method.
+func (m *MockRepository) GetReportById(id uint) (*models.MessageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportById", id)
+	ret0, _ := ret[0].(*models.MessageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportById indicates an expected call of GetReportById.
+func (mr *MockRepositoryMockRecorder) GetReportById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportById", reflect.TypeOf((*MockRepository)(nil).GetReportById), id)
+}
+
+// GetReports mocks base method.
+func (m *MockRepository) GetReports(schoolId *uint, status models.MessageReportStatus) (*[]models.MessageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReports", schoolId, status)
+	ret0, _ := ret[0].(*[]models.MessageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReports indicates an expected call of GetReports.
+func (mr *MockRepositoryMockRecorder) GetReports(schoolId, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReports", reflect.TypeOf((*MockRepository)(nil).GetReports), schoolId, status)
+}
+
+// GetUnreadCounts mocks base method.
+func (m *MockRepository) GetUnreadCounts(userId uint, channelIds []uint) (*[]models.ChannelUnread, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCounts", userId, channelIds)
+	ret0, _ := ret[0].(*[]models.ChannelUnread)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCounts indicates an expected call of GetUnreadCounts.
+func (mr *MockRepositoryMockRecorder) GetUnreadCounts(userId, channelIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCounts", reflect.TypeOf((*MockRepository)(nil).GetUnreadCounts), userId, channelIds)
+}
+
+// IsBlocked mocks base method.
+func (m *MockRepository) IsBlocked(firstUserId, secondUserId uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", firstUserId, secondUserId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockRepositoryMockRecorder) IsBlocked(firstUserId, secondUserId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockRepository)(nil).IsBlocked), firstUserId, secondUserId)
+}
+
+// IsDirectChannelBlocked mocks base method.
+func (m *MockRepository) IsDirectChannelBlocked(channelId uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsDirectChannelBlocked", channelId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsDirectChannelBlocked indicates an expected call of IsDirectChannelBlocked.
+func (mr *MockRepositoryMockRecorder) IsDirectChannelBlocked(channelId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDirectChannelBlocked", reflect.TypeOf((*MockRepository)(nil).IsDirectChannelBlocked), channelId)
+}
+
+// IsMember mocks base method.
+func (m *MockRepository) IsMember(channelId, userId uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsMember", channelId, userId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsMember indicates an expected call of IsMember.
+func (mr *MockRepositoryMockRecorder) IsMember(channelId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMember", reflect.TypeOf((*MockRepository)(nil).IsMember), channelId, userId)
+}
+
+// MarkRead mocks base method.
+func (m *MockRepository) MarkRead(channelId, userId, messageId uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", channelId, userId, messageId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockRepositoryMockRecorder) MarkRead(channelId, userId, messageId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockRepository)(nil).MarkRead), channelId, userId, messageId)
+}
+
+// RemoveMembers mocks base method.
+func (m *MockRepository) RemoveMembers(channelId uint, userIds []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMembers", channelId, userIds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMembers indicates an expected call of RemoveMembers.
+func (mr *MockRepositoryMockRecorder) RemoveMembers(channelId, userIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMembers", reflect.TypeOf((*MockRepository)(nil).RemoveMembers), channelId, userIds)
+}
+
 // SaveMessage mocks base method.
-func (m *MockRepository) SaveMessage(msg *models.Message) (*models.Message, error) {
+func (m *MockRepository) SaveMessage(msg *models.Message, attachmentIds []uint) (*models.Message, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveMessage", msg)
+	ret := m.ctrl.Call(m, "SaveMessage", msg, attachmentIds)
 	ret0, _ := ret[0].(*models.Message)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SaveMessage indicates an expected call of SaveMessage.
-func (mr *MockRepositoryMockRecorder) SaveMessage(msg any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) SaveMessage(msg, attachmentIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMessage", reflect.TypeOf((*MockRepository)(nil).SaveMessage), msg, attachmentIds)
+}
+
+// SearchMessages mocks base method.
+func (m *MockRepository) SearchMessages(userId uint, query string, before uint, limit int) (*[]models.MessageSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", userId, query, before, limit)
+	ret0, _ := ret[0].(*[]models.MessageSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockRepositoryMockRecorder) SearchMessages(userId, query, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockRepository)(nil).SearchMessages), userId, query, before, limit)
+}
+
+// Unblock mocks base method.
+func (m *MockRepository) Unblock(blockerId, blockedId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unblock", blockerId, blockedId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unblock indicates an expected call of Unblock.
+func (mr *MockRepositoryMockRecorder) Unblock(blockerId, blockedId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unblock", reflect.TypeOf((*MockRepository)(nil).Unblock), blockerId, blockedId)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(channel *models.Channel) (*models.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", channel)
+	ret0, _ := ret[0].(*models.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(channel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), channel)
+}
+
+// UpdateMessage mocks base method.
+func (m *MockRepository) UpdateMessage(msg *models.Message, revision *models.MessageRevision) (*models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMessage", msg, revision)
+	ret0, _ := ret[0].(*models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMessage indicates an expected call of UpdateMessage.
+func (mr *MockRepositoryMockRecorder) UpdateMessage(msg, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMessage", reflect.TypeOf((*MockRepository)(nil).UpdateMessage), msg, revision)
+}
+
+// UpdateReport mocks base method.
+func (m *MockRepository) UpdateReport(report *models.MessageReport) (*models.MessageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReport", report)
+	ret0, _ := ret[0].(*models.MessageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReport indicates an expected call of UpdateReport.
+func (mr *MockRepositoryMockRecorder) UpdateReport(report any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMessage", reflect.TypeOf((*MockRepository)(nil).SaveMessage), msg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReport", reflect.TypeOf((*MockRepository)(nil).UpdateReport), report)
 }
@@ -39,6 +39,20 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 	return m.recorder
 }
 
+// BlockUser mocks base method.
+func (m *MockUseCase) BlockUser(user *models.User, blockedId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUser", user, blockedId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockUseCaseMockRecorder) BlockUser(user, blockedId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockUseCase)(nil).BlockUser), user, blockedId)
+}
+
 // Create mocks base method.
 func (m *MockUseCase) Create(chat *models.Channel) (*models.Channel, error) {
 	m.ctrl.T.Helper()
@@ -54,6 +68,50 @@ func (mr *MockUseCaseMockRecorder) Create(chat any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUseCase)(nil).Create), chat)
 }
 
+// DeleteChannels mocks base method.
+func (m *MockUseCase) DeleteChannels(kind models.ChannelKind, refId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannels", kind, refId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChannels indicates an expected call of DeleteChannels.
+func (mr *MockUseCaseMockRecorder) DeleteChannels(kind, refId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannels", reflect.TypeOf((*MockUseCase)(nil).DeleteChannels), kind, refId)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockUseCase) DeleteMessage(user *models.User, messageId uint) (*models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", user, messageId)
+	ret0, _ := ret[0].(*models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockUseCaseMockRecorder) DeleteMessage(user, messageId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockUseCase)(nil).DeleteMessage), user, messageId)
+}
+
+// EditMessage mocks base method.
+func (m *MockUseCase) EditMessage(user *models.User, messageId uint, content string) (*models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditMessage", user, messageId, content)
+	ret0, _ := ret[0].(*models.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditMessage indicates an expected call of EditMessage.
+func (mr *MockUseCaseMockRecorder) EditMessage(user, messageId, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditMessage", reflect.TypeOf((*MockUseCase)(nil).EditMessage), user, messageId, content)
+}
+
 // GetAllByUser mocks base method.
 func (m *MockUseCase) GetAllByUser(userId uint) (*[]models.Channel, error) {
 	m.ctrl.T.Helper()
@@ -99,32 +157,382 @@ func (mr *MockUseCaseMockRecorder) GetAllPossibleChatTeacher(user any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPossibleChatTeacher", reflect.TypeOf((*MockUseCase)(nil).GetAllPossibleChatTeacher), user)
 }
 
+// GetAttachment mocks base method.
+func (m *MockUseCase) GetAttachment(user *models.User, id uint, thumbnail bool) (*models.MessageAttachment, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachment", user, id, thumbnail)
+	ret0, _ := ret[0].(*models.MessageAttachment)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAttachment indicates an expected call of GetAttachment.
+func (mr *MockUseCaseMockRecorder) GetAttachment(user, id, thumbnail any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachment", reflect.TypeOf((*MockUseCase)(nil).GetAttachment), user, id, thumbnail)
+}
+
+// GetBlockedUsers mocks base method.
+func (m *MockUseCase) GetBlockedUsers(user *models.User) (*[]models.UserBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedUsers", user)
+	ret0, _ := ret[0].(*[]models.UserBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUsers indicates an expected call of GetBlockedUsers.
+func (mr *MockUseCaseMockRecorder) GetBlockedUsers(user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsers", reflect.TypeOf((*MockUseCase)(nil).GetBlockedUsers), user)
+}
+
 // GetById mocks base method.
-func (m *MockUseCase) GetById(id uint) (*models.Channel, error) {
+func (m *MockUseCase) GetById(user *models.User, id uint) (*models.Channel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetById", id)
+	ret := m.ctrl.Call(m, "GetById", user, id)
 	ret0, _ := ret[0].(*models.Channel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetById indicates an expected call of GetById.
-func (mr *MockUseCaseMockRecorder) GetById(id any) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) GetById(user, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockUseCase)(nil).GetById), user, id)
+}
+
+// GetMessageHistory mocks base method.
+func (m *MockUseCase) GetMessageHistory(user *models.User, messageId uint) (*models.MessageHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageHistory", user, messageId)
+	ret0, _ := ret[0].(*models.MessageHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageHistory indicates an expected call of GetMessageHistory.
+func (mr *MockUseCaseMockRecorder) GetMessageHistory(user, messageId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageHistory", reflect.TypeOf((*MockUseCase)(nil).GetMessageHistory), user, messageId)
+}
+
+// GetMessages mocks base method.
+func (m *MockUseCase) GetMessages(user *models.User, channelId uint, before *uint, limit int) (*models.MessagePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessages", user, channelId, before, limit)
+	ret0, _ := ret[0].(*models.MessagePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessages indicates an expected call of GetMessages.
+func (mr *MockUseCaseMockRecorder) GetMessages(user, channelId, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessages", reflect.TypeOf((*MockUseCase)(nil).GetMessages), user, channelId, before, limit)
+}
+
+// GetMutes mocks base method.
+func (m *MockUseCase) GetMutes(user *models.User) (*[]models.ChatMute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMutes", user)
+	ret0, _ := ret[0].(*[]models.ChatMute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMutes indicates an expected call of GetMutes.
+func (mr *MockUseCaseMockRecorder) GetMutes(user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMutes", reflect.TypeOf((*MockUseCase)(nil).GetMutes), user)
+}
+
+// GetReports mocks base method.
+func (m *MockUseCase) GetReports(user *models.User, status models.MessageReportStatus) (*[]models.MessageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReports", user, status)
+	ret0, _ := ret[0].(*[]models.MessageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReports indicates an expected call of GetReports.
+func (mr *MockUseCaseMockRecorder) GetReports(user, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReports", reflect.TypeOf((*MockUseCase)(nil).GetReports), user, status)
+}
+
+// GetUnread mocks base method.
+func (m *MockUseCase) GetUnread(user *models.User) (*models.UnreadSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnread", user)
+	ret0, _ := ret[0].(*models.UnreadSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnread indicates an expected call of GetUnread.
+func (mr *MockUseCaseMockRecorder) GetUnread(user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnread", reflect.TypeOf((*MockUseCase)(nil).GetUnread), user)
+}
+
+// IsMember mocks base method.
+func (m *MockUseCase) IsMember(channelId, userId uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsMember", channelId, userId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsMember indicates an expected call of IsMember.
+func (mr *MockUseCaseMockRecorder) IsMember(channelId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsMember", reflect.TypeOf((*MockUseCase)(nil).IsMember), channelId, userId)
+}
+
+// MarkRead mocks base method.
+func (m *MockUseCase) MarkRead(user *models.User, channelId uint, read *models.ChannelRead) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", user, channelId, read)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockUseCaseMockRecorder) MarkRead(user, channelId, read any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockUseCase)(nil).MarkRead), user, channelId, read)
+}
+
+// MuteUser mocks base method.
+func (m *MockUseCase) MuteUser(user *models.User, mute *models.ChatMuteCreate) (*models.ChatMute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MuteUser", user, mute)
+	ret0, _ := ret[0].(*models.ChatMute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MuteUser indicates an expected call of MuteUser.
+func (mr *MockUseCaseMockRecorder) MuteUser(user, mute any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MuteUser", reflect.TypeOf((*MockUseCase)(nil).MuteUser), user, mute)
+}
+
+// PublishPresence mocks base method.
+func (m *MockUseCase) PublishPresence(userId uint, online bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishPresence", userId, online)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishPresence indicates an expected call of PublishPresence.
+func (mr *MockUseCaseMockRecorder) PublishPresence(userId, online any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishPresence", reflect.TypeOf((*MockUseCase)(nil).PublishPresence), userId, online)
+}
+
+// PurgePendingAttachments mocks base method.
+func (m *MockUseCase) PurgePendingAttachments() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgePendingAttachments")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgePendingAttachments indicates an expected call of PurgePendingAttachments.
+func (mr *MockUseCaseMockRecorder) PurgePendingAttachments() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgePendingAttachments", reflect.TypeOf((*MockUseCase)(nil).PurgePendingAttachments))
+}
+
+// ReportMessage mocks base method.
+func (m *MockUseCase) ReportMessage(user *models.User, messageId uint, report *models.MessageReportCreate) (*models.MessageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportMessage", user, messageId, report)
+	ret0, _ := ret[0].(*models.MessageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportMessage indicates an expected call of ReportMessage.
+func (mr *MockUseCaseMockRecorder) ReportMessage(user, messageId, report any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockUseCase)(nil).GetById), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportMessage", reflect.TypeOf((*MockUseCase)(nil).ReportMessage), user, messageId, report)
 }
 
-// SaveMessage mocks base method.
-func (m *MockUseCase) SaveMessage(msg *models.Message) (*models.Message, error) {
+// ReviewReport mocks base method.
+func (m *MockUseCase) ReviewReport(user *models.User, id uint, review *models.MessageReportReview) (*models.MessageReport, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveMessage", msg)
+	ret := m.ctrl.Call(m, "ReviewReport", user, id, review)
+	ret0, _ := ret[0].(*models.MessageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewReport indicates an expected call of ReviewReport.
+func (mr *MockUseCaseMockRecorder) ReviewReport(user, id, review any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewReport", reflect.TypeOf((*MockUseCase)(nil).ReviewReport), user, id, review)
+}
+
+// SearchMessages mocks base method.
+func (m *MockUseCase) SearchMessages(user *models.User, query string, before *uint, limit int) (*models.MessageSearchPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchMessages", user, query, before, limit)
+	ret0, _ := ret[0].(*models.MessageSearchPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchMessages indicates an expected call of SearchMessages.
+func (mr *MockUseCaseMockRecorder) SearchMessages(user, query, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchMessages", reflect.TypeOf((*MockUseCase)(nil).SearchMessages), user, query, before, limit)
+}
+
+// SendMessage mocks base method.
+func (m *MockUseCase) SendMessage(user *models.User, channelId uint, content string, attachmentIds []uint) (*models.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMessage", user, channelId, content, attachmentIds)
 	ret0, _ := ret[0].(*models.Message)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SaveMessage indicates an expected call of SaveMessage.
-func (mr *MockUseCaseMockRecorder) SaveMessage(msg any) *gomock.Call {
+// SendMessage indicates an expected call of SendMessage.
+func (mr *MockUseCaseMockRecorder) SendMessage(user, channelId, content, attachmentIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMessage", reflect.TypeOf((*MockUseCase)(nil).SendMessage), user, channelId, content, attachmentIds)
+}
+
+// SendTyping mocks base method.
+func (m *MockUseCase) SendTyping(user *models.User, channelId uint, typing bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTyping", user, channelId, typing)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendTyping indicates an expected call of SendTyping.
+func (mr *MockUseCaseMockRecorder) SendTyping(user, channelId, typing any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTyping", reflect.TypeOf((*MockUseCase)(nil).SendTyping), user, channelId, typing)
+}
+
+// SyncClassChannels mocks base method.
+func (m *MockUseCase) SyncClassChannels(classId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncClassChannels", classId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncClassChannels indicates an expected call of SyncClassChannels.
+func (mr *MockUseCaseMockRecorder) SyncClassChannels(classId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncClassChannels", reflect.TypeOf((*MockUseCase)(nil).SyncClassChannels), classId)
+}
+
+// SyncCourseChannel mocks base method.
+func (m *MockUseCase) SyncCourseChannel(courseId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncCourseChannel", courseId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncCourseChannel indicates an expected call of SyncCourseChannel.
+func (mr *MockUseCaseMockRecorder) SyncCourseChannel(courseId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncCourseChannel", reflect.TypeOf((*MockUseCase)(nil).SyncCourseChannel), courseId)
+}
+
+// SyncGroupChannels mocks base method.
+func (m *MockUseCase) SyncGroupChannels() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncGroupChannels")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncGroupChannels indicates an expected call of SyncGroupChannels.
+func (mr *MockUseCaseMockRecorder) SyncGroupChannels() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncGroupChannels", reflect.TypeOf((*MockUseCase)(nil).SyncGroupChannels))
+}
+
+// SyncPathChannels mocks base method.
+func (m *MockUseCase) SyncPathChannels(pathId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncPathChannels", pathId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncPathChannels indicates an expected call of SyncPathChannels.
+func (mr *MockUseCaseMockRecorder) SyncPathChannels(pathId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncPathChannels", reflect.TypeOf((*MockUseCase)(nil).SyncPathChannels), pathId)
+}
+
+// SyncProjectGroupChannel mocks base method.
+func (m *MockUseCase) SyncProjectGroupChannel(projectId, group uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncProjectGroupChannel", projectId, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncProjectGroupChannel indicates an expected call of SyncProjectGroupChannel.
+func (mr *MockUseCaseMockRecorder) SyncProjectGroupChannel(projectId, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncProjectGroupChannel", reflect.TypeOf((*MockUseCase)(nil).SyncProjectGroupChannel), projectId, group)
+}
+
+// UnblockUser mocks base method.
+func (m *MockUseCase) UnblockUser(user *models.User, blockedId uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockUser", user, blockedId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockUseCaseMockRecorder) UnblockUser(user, blockedId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockUseCase)(nil).UnblockUser), user, blockedId)
+}
+
+// Unmute mocks base method.
+func (m *MockUseCase) Unmute(user *models.User, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unmute", user, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unmute indicates an expected call of Unmute.
+func (mr *MockUseCaseMockRecorder) Unmute(user, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unmute", reflect.TypeOf((*MockUseCase)(nil).Unmute), user, id)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockUseCase) UploadAttachment(user *models.User, channelId uint, attachment *models.MessageAttachmentCreate) (*models.MessageAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", user, channelId, attachment)
+	ret0, _ := ret[0].(*models.MessageAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockUseCaseMockRecorder) UploadAttachment(user, channelId, attachment any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveMessage", reflect.TypeOf((*MockUseCase)(nil).SaveMessage), msg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockUseCase)(nil).UploadAttachment), user, channelId, attachment)
 }
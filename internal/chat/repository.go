@@ -1,12 +1,71 @@
 package chat
 
 import (
+	"errors"
+	"time"
+
 	"github.com/esgi-challenge/backend/internal/models"
 )
 
+// An attachment was linked to another message or purged since it was checked
+var ErrAttachmentsTaken = errors.New("attachments already linked to a message")
+
 type Repository interface {
 	Create(chat *models.Channel) (*models.Channel, error)
-	SaveMessage(msg *models.Message) (*models.Message, error)
+	// Save the message and link the attachments to it, nothing is saved and ErrAttachmentsTaken is
+	// returned when one of them is not pending anymore
+	SaveMessage(msg *models.Message, attachmentIds []uint) (*models.Message, error)
 	GetAllByUser(userId uint) (*[]models.Channel, error)
 	GetById(id uint) (*models.Channel, error)
+	// Newest first, only messages older than before when it is set
+	GetMessages(channelId uint, before *uint, limit int) (*[]models.Message, error)
+	// Newest first, only messages older than before when it is not 0
+	SearchMessages(userId uint, query string, before uint, limit int) (*[]models.MessageSearchResult, error)
+	GetLastMessages(channelIds []uint) (*[]models.Message, error)
+	GetUnreadCounts(userId uint, channelIds []uint) (*[]models.ChannelUnread, error)
+	GetMessageById(id uint) (*models.Message, error)
+	GetMessageWithSender(id uint) (*models.Message, error)
+	// Save the message and keep its previous content in the revisions
+	UpdateMessage(msg *models.Message, revision *models.MessageRevision) (*models.Message, error)
+	GetMessageRevisions(messageId uint) (*[]models.MessageRevision, error)
+	// Only moves the last read message forward, returns false when it was already read
+	MarkRead(channelId uint, userId uint, messageId uint) (bool, error)
+	GetMemberChannelIds(userId uint) ([]uint, error)
+	CreateAttachment(attachment *models.MessageAttachment) (*models.MessageAttachment, error)
+	GetAttachmentById(id uint) (*models.MessageAttachment, error)
+	GetAttachmentsByIds(ids []uint) (*[]models.MessageAttachment, error)
+	// Attachments never sent with a message
+	GetPendingAttachmentsBefore(date time.Time) (*[]models.MessageAttachment, error)
+	DeleteAttachment(id uint) error
+	CreateReport(report *models.MessageReport) (*models.MessageReport, error)
+	GetReportById(id uint) (*models.MessageReport, error)
+	// Every school when schoolId is nil, every status when status is empty
+	GetReports(schoolId *uint, status models.MessageReportStatus) (*[]models.MessageReport, error)
+	UpdateReport(report *models.MessageReport) (*models.MessageReport, error)
+	Block(block *models.UserBlock) error
+	Unblock(blockerId uint, blockedId uint) error
+	GetBlocks(blockerId uint) (*[]models.UserBlock, error)
+	// True when one of the users blocked the other
+	IsBlocked(firstUserId uint, secondUserId uint) (bool, error)
+	IsDirectChannelBlocked(channelId uint) (bool, error)
+	CreateMute(mute *models.ChatMute) (*models.ChatMute, error)
+	GetMuteById(id uint) (*models.ChatMute, error)
+	// Mute ending last among the ones not over yet
+	GetActiveMute(userId uint) (*models.ChatMute, error)
+	// Every school when schoolId is nil
+	GetActiveMutes(schoolId *uint) (*[]models.ChatMute, error)
+	DeleteMute(id uint) error
+	// Channel provisioned for a class, course or project group, group is only used for project groups
+	GetByRef(kind models.ChannelKind, refId uint, group *uint) (*models.Channel, error)
+	Update(channel *models.Channel) (*models.Channel, error)
+	DeleteByRef(kind models.ChannelKind, refId uint) error
+	IsMember(channelId uint, userId uint) (bool, error)
+	GetMemberIds(channelId uint) ([]uint, error)
+	AddMembers(channelId uint, userIds []uint) error
+	RemoveMembers(channelId uint, userIds []uint) error
+	GetClassMemberIds(classId uint) ([]uint, error)
+	GetCourseMemberIds(course *models.Course) ([]uint, error)
+	GetProjectGroupMemberIds(projectId uint, group uint) ([]uint, error)
+	GetCourseIdsByPath(pathId uint) ([]uint, error)
+	GetAllProjectGroups() (*[]models.ProjectStudent, error)
 }
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"gorm.io/gorm/clause"
+)
+
+func (r *chatRepo) CreateReport(report *models.MessageReport) (*models.MessageReport, error) {
+	if err := r.db.Create(report).Error; err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func (r *chatRepo) GetReportById(id uint) (*models.MessageReport, error) {
+	var report models.MessageReport
+
+	if err := r.db.Preload("Message.Sender").Preload("Reporter").First(&report, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+func (r *chatRepo) GetReports(schoolId *uint, status models.MessageReportStatus) (*[]models.MessageReport, error) {
+	var reports []models.MessageReport
+
+	query := r.db.Model(&models.MessageReport{}).Preload("Message.Sender").Preload("Reporter")
+
+	if schoolId != nil {
+		query = query.Where("school_id = ?", *schoolId)
+	}
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Order("id DESC").Find(&reports).Error; err != nil {
+		return nil, err
+	}
+
+	return &reports, nil
+}
+
+func (r *chatRepo) UpdateReport(report *models.MessageReport) (*models.MessageReport, error) {
+	if err := r.db.Model(report).Select("status", "reviewer_id", "reviewed_at").Updates(report).Error; err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// Blocking twice is not an error
+func (r *chatRepo) Block(block *models.UserBlock) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(block).Error
+}
+
+func (r *chatRepo) Unblock(blockerId uint, blockedId uint) error {
+	return r.db.Where("blocker_id = ? AND blocked_id = ?", blockerId, blockedId).Delete(&models.UserBlock{}).Error
+}
+
+func (r *chatRepo) GetBlocks(blockerId uint) (*[]models.UserBlock, error) {
+	blocks := []models.UserBlock{}
+
+	if err := r.db.Preload("Blocked").Where("blocker_id = ?", blockerId).Order("created_at DESC").Find(&blocks).Error; err != nil {
+		return nil, err
+	}
+
+	return &blocks, nil
+}
+
+func (r *chatRepo) IsBlocked(firstUserId uint, secondUserId uint) (bool, error) {
+	var count int64
+
+	if err := r.db.Model(&models.UserBlock{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", firstUserId, secondUserId, secondUserId, firstUserId).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *chatRepo) IsDirectChannelBlocked(channelId uint) (bool, error) {
+	var count int64
+
+	if err := r.db.Model(&models.UserBlock{}).
+		Joins("JOIN channels ON channels.id = ? AND channels.kind = ?", channelId, models.CHANNEL_DIRECT).
+		Where("(user_blocks.blocker_id = channels.first_user_id AND user_blocks.blocked_id = channels.second_user_id) OR (user_blocks.blocker_id = channels.second_user_id AND user_blocks.blocked_id = channels.first_user_id)").
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *chatRepo) CreateMute(mute *models.ChatMute) (*models.ChatMute, error) {
+	if err := r.db.Create(mute).Error; err != nil {
+		return nil, err
+	}
+
+	return mute, nil
+}
+
+func (r *chatRepo) GetMuteById(id uint) (*models.ChatMute, error) {
+	var mute models.ChatMute
+
+	if err := r.db.First(&mute, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &mute, nil
+}
+
+func (r *chatRepo) GetActiveMute(userId uint) (*models.ChatMute, error) {
+	var mute models.ChatMute
+
+	if err := r.db.Where("user_id = ? AND until > ?", userId, time.Now()).Order("until DESC").First(&mute).Error; err != nil {
+		return nil, err
+	}
+
+	return &mute, nil
+}
+
+func (r *chatRepo) GetActiveMutes(schoolId *uint) (*[]models.ChatMute, error) {
+	var mutes []models.ChatMute
+
+	query := r.db.Model(&models.ChatMute{}).Preload("User").Where("until > ?", time.Now())
+
+	if schoolId != nil {
+		query = query.Where("school_id = ?", *schoolId)
+	}
+
+	if err := query.Order("until").Find(&mutes).Error; err != nil {
+		return nil, err
+	}
+
+	return &mutes, nil
+}
+
+func (r *chatRepo) DeleteMute(id uint) error {
+	return r.db.Delete(&models.ChatMute{}, id).Error
+}
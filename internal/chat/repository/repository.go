@@ -1,9 +1,14 @@
 package repository
 
 import (
+	"database/sql"
+	"fmt"
+	"time"
+
 	"github.com/esgi-challenge/backend/internal/chat"
 	"github.com/esgi-challenge/backend/internal/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type chatRepo struct {
@@ -14,8 +19,30 @@ func NewChatRepository(db *gorm.DB) chat.Repository {
 	return &chatRepo{db: db}
 }
 
-func (r *chatRepo) SaveMessage(msg *models.Message) (*models.Message, error) {
-	if err := r.db.Create(msg).Error; err != nil {
+func (r *chatRepo) SaveMessage(msg *models.Message, attachmentIds []uint) (*models.Message, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(msg).Error; err != nil {
+			return err
+		}
+
+		if len(attachmentIds) == 0 {
+			return nil
+		}
+
+		result := tx.Model(&models.MessageAttachment{}).Where("id IN ? AND message_id IS NULL", attachmentIds).Update("message_id", msg.ID)
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected != int64(len(attachmentIds)) {
+			return chat.ErrAttachmentsTaken
+		}
+
+		return tx.Where("message_id = ?", msg.ID).Order("id").Find(&msg.Attachments).Error
+	})
+
+	if err != nil {
 		return nil, err
 	}
 
@@ -33,7 +60,9 @@ func (r *chatRepo) Create(channel *models.Channel) (*models.Channel, error) {
 func (r *chatRepo) GetAllByUser(userId uint) (*[]models.Channel, error) {
 	var channels []models.Channel
 
-	if err := r.db.Model(&models.Channel{}).Preload("FirstUser").Preload("SecondUser").Preload("Messages").Where("first_user_id = ? OR second_user_id = ?", userId, userId).Find(&channels).Error; err != nil {
+	memberOf := r.db.Model(&models.ChannelMember{}).Select("channel_id").Where("user_id = ?", userId)
+
+	if err := r.db.Model(&models.Channel{}).Preload("FirstUser").Preload("SecondUser").Preload("Members.User").Where("id IN (?)", memberOf).Find(&channels).Error; err != nil {
 		return nil, err
 	}
 
@@ -43,9 +72,340 @@ func (r *chatRepo) GetAllByUser(userId uint) (*[]models.Channel, error) {
 func (r *chatRepo) GetById(id uint) (*models.Channel, error) {
 	var channel models.Channel
 
-	if err := r.db.Model(&models.Channel{}).Preload("FirstUser").Preload("SecondUser").Preload("Messages").First(&channel, id).Error; err != nil {
+	if err := r.db.Model(&models.Channel{}).Preload("FirstUser").Preload("SecondUser").Preload("Members.User").First(&channel, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &channel, nil
+}
+
+func (r *chatRepo) GetMessages(channelId uint, before *uint, limit int) (*[]models.Message, error) {
+	var messages []models.Message
+
+	query := r.db.Model(&models.Message{}).Preload("Attachments").Where("channel_id = ?", channelId)
+
+	if before != nil {
+		query = query.Where("id < ?", *before)
+	}
+
+	if err := query.Order("id DESC").Limit(limit).Find(&messages).Error; err != nil {
+		return nil, err
+	}
+
+	return &messages, nil
+}
+
+func (r *chatRepo) SearchMessages(userId uint, query string, before uint, limit int) (*[]models.MessageSearchResult, error) {
+	results := []models.MessageSearchResult{}
+
+	headlineOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=2, MaxWords=20, MinWords=5", models.DocumentHighlightStart, models.DocumentHighlightStop)
+
+	if err := r.db.Raw(searchMessagesQuery,
+		sql.Named("options", headlineOptions),
+		sql.Named("user", userId),
+		sql.Named("query", query),
+		sql.Named("before", before),
+		sql.Named("limit", limit),
+	).Scan(&results).Error; err != nil {
+		return nil, err
+	}
+
+	return &results, nil
+}
+
+func (r *chatRepo) GetLastMessages(channelIds []uint) (*[]models.Message, error) {
+	messages := []models.Message{}
+
+	if len(channelIds) == 0 {
+		return &messages, nil
+	}
+
+	if err := r.db.Raw(lastMessagesQuery, channelIds).Scan(&messages).Error; err != nil {
+		return nil, err
+	}
+
+	return &messages, nil
+}
+
+func (r *chatRepo) GetUnreadCounts(userId uint, channelIds []uint) (*[]models.ChannelUnread, error) {
+	unreads := []models.ChannelUnread{}
+
+	if len(channelIds) == 0 {
+		return &unreads, nil
+	}
+
+	if err := r.db.Raw(unreadCountsQuery, sql.Named("user", userId), sql.Named("channels", channelIds)).Scan(&unreads).Error; err != nil {
+		return nil, err
+	}
+
+	return &unreads, nil
+}
+
+func (r *chatRepo) GetMessageById(id uint) (*models.Message, error) {
+	var message models.Message
+
+	if err := r.db.First(&message, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &message, nil
+}
+
+func (r *chatRepo) GetMessageWithSender(id uint) (*models.Message, error) {
+	var message models.Message
+
+	if err := r.db.Preload("Sender").First(&message, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &message, nil
+}
+
+func (r *chatRepo) UpdateMessage(msg *models.Message, revision *models.MessageRevision) (*models.Message, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(revision).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(msg).Select("content", "edited_at", "removed_at").Updates(msg).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func (r *chatRepo) GetMessageRevisions(messageId uint) (*[]models.MessageRevision, error) {
+	revisions := []models.MessageRevision{}
+
+	if err := r.db.Where("message_id = ?", messageId).Order("id").Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+
+	return &revisions, nil
+}
+
+func (r *chatRepo) MarkRead(channelId uint, userId uint, messageId uint) (bool, error) {
+	result := r.db.Model(&models.ChannelMember{}).
+		Where("channel_id = ? AND user_id = ?", channelId, userId).
+		Where("last_read_message_id IS NULL OR last_read_message_id < ?", messageId).
+		Update("last_read_message_id", messageId)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *chatRepo) GetMemberChannelIds(userId uint) ([]uint, error) {
+	var ids []uint
+
+	// Channels of deleted classes, courses or projects keep their members
+	if err := r.db.Model(&models.ChannelMember{}).
+		Joins("JOIN channels ON channels.id = channel_members.channel_id AND channels.deleted_at IS NULL").
+		Where("channel_members.user_id = ?", userId).
+		Pluck("channel_members.channel_id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *chatRepo) CreateAttachment(attachment *models.MessageAttachment) (*models.MessageAttachment, error) {
+	if err := r.db.Create(attachment).Error; err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+func (r *chatRepo) GetAttachmentById(id uint) (*models.MessageAttachment, error) {
+	var attachment models.MessageAttachment
+
+	if err := r.db.First(&attachment, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+func (r *chatRepo) GetAttachmentsByIds(ids []uint) (*[]models.MessageAttachment, error) {
+	var attachments []models.MessageAttachment
+
+	if err := r.db.Where("id IN ?", ids).Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+
+	return &attachments, nil
+}
+
+func (r *chatRepo) GetPendingAttachmentsBefore(date time.Time) (*[]models.MessageAttachment, error) {
+	var attachments []models.MessageAttachment
+
+	if err := r.db.Where("message_id IS NULL AND created_at < ?", date).Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+
+	return &attachments, nil
+}
+
+// The files are removed first, the row is not kept
+func (r *chatRepo) DeleteAttachment(id uint) error {
+	return r.db.Unscoped().Delete(&models.MessageAttachment{}, id).Error
+}
+
+func refQuery(db *gorm.DB, kind models.ChannelKind, refId uint) *gorm.DB {
+	query := db.Where("kind = ?", kind)
+
+	switch kind {
+	case models.CHANNEL_CLASS:
+		return query.Where("class_id = ?", refId)
+	case models.CHANNEL_COURSE:
+		return query.Where("course_id = ?", refId)
+	case models.CHANNEL_PROJECT_GROUP:
+		return query.Where("project_id = ?", refId)
+	default:
+		return query.Where("id = ?", refId)
+	}
+}
+
+func (r *chatRepo) GetByRef(kind models.ChannelKind, refId uint, group *uint) (*models.Channel, error) {
+	var channel models.Channel
+
+	query := refQuery(r.db.Model(&models.Channel{}), kind, refId)
+
+	if group != nil {
+		query = query.Where("project_group = ?", *group)
+	}
+
+	if err := query.First(&channel).Error; err != nil {
 		return nil, err
 	}
 
 	return &channel, nil
 }
+
+func (r *chatRepo) Update(channel *models.Channel) (*models.Channel, error) {
+	if err := r.db.Omit(clause.Associations).Save(channel).Error; err != nil {
+		return nil, err
+	}
+
+	return channel, nil
+}
+
+func (r *chatRepo) DeleteByRef(kind models.ChannelKind, refId uint) error {
+	if err := refQuery(r.db, kind, refId).Delete(&models.Channel{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *chatRepo) IsMember(channelId uint, userId uint) (bool, error) {
+	var count int64
+
+	if err := r.db.Model(&models.ChannelMember{}).Where("channel_id = ? AND user_id = ?", channelId, userId).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *chatRepo) GetMemberIds(channelId uint) ([]uint, error) {
+	var ids []uint
+
+	if err := r.db.Model(&models.ChannelMember{}).Where("channel_id = ?", channelId).Pluck("user_id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *chatRepo) AddMembers(channelId uint, userIds []uint) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	var members []models.ChannelMember
+	for _, userId := range userIds {
+		members = append(members, models.ChannelMember{ChannelId: channelId, UserId: userId})
+	}
+
+	if err := r.db.Omit("User").Clauses(clause.OnConflict{DoNothing: true}).Create(&members).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *chatRepo) RemoveMembers(channelId uint, userIds []uint) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	if err := r.db.Where("channel_id = ? AND user_id IN ?", channelId, userIds).Delete(&models.ChannelMember{}).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *chatRepo) GetClassMemberIds(classId uint) ([]uint, error) {
+	var ids []uint
+
+	if err := r.db.Model(&models.User{}).Where("class_refer = ?", classId).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// The teacher and the students of the classes following the course path
+func (r *chatRepo) GetCourseMemberIds(course *models.Course) ([]uint, error) {
+	var ids []uint
+
+	classes := r.db.Model(&models.Class{}).Select("id").Where("path_id = ?", course.PathId)
+
+	if err := r.db.Model(&models.User{}).Where("class_refer IN (?) OR id = ?", classes, course.TeacherId).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// The teacher of the project and the students of the group
+func (r *chatRepo) GetProjectGroupMemberIds(projectId uint, group uint) ([]uint, error) {
+	var ids []uint
+
+	students := r.db.Model(&models.ProjectStudent{}).Select("student_id").Where("project_id = ? AND \"group\" = ?", projectId, group)
+	teacher := r.db.Model(&models.Project{}).Select("teacher_id").Where("id = ?", projectId)
+
+	if err := r.db.Model(&models.User{}).Where("id IN (?) OR id IN (?)", students, teacher).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *chatRepo) GetCourseIdsByPath(pathId uint) ([]uint, error) {
+	var ids []uint
+
+	if err := r.db.Model(&models.Course{}).Where(&models.Course{PathId: pathId}).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *chatRepo) GetAllProjectGroups() (*[]models.ProjectStudent, error) {
+	var groups []models.ProjectStudent
+
+	if err := r.db.Model(&models.ProjectStudent{}).Distinct("project_id", "group").Find(&groups).Error; err != nil {
+		return nil, err
+	}
+
+	return &groups, nil
+}
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/esgi-challenge/backend/internal/chat"
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func setupMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       db,
+		DriverName: "postgres",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(t, err)
+
+	return gormDB, mock
+}
+
+func TestSearchMessages(t *testing.T) {
+	t.Parallel()
+
+	db, mock := setupMockDB(t)
+	repo := NewChatRepository(db)
+
+	t.Run("only the channels of the user", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "content", "channel_id", "channel_kind", "channel_name", "snippet"}).
+			AddRow(12, "see you tomorrow", 5, "class", "Class A", "see you \x02tomorrow\x03")
+
+		mock.ExpectQuery(regexp.QuoteMeta("JOIN channel_members ON channel_members.channel_id = messages.channel_id AND channel_members.user_id = $2")).
+			WithArgs(sqlmock.AnyArg(), 4, "tomorrow", 0, 0, 21).
+			WillReturnRows(rows)
+
+		results, err := repo.SearchMessages(4, "tomorrow", 0, 21)
+
+		assert.NoError(t, err)
+		assert.Len(t, *results, 1)
+		assert.Equal(t, uint(5), (*results)[0].ChannelId)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSaveMessage(t *testing.T) {
+	t.Parallel()
+
+	db, mock := setupMockDB(t)
+	repo := NewChatRepository(db)
+
+	t.Run("attachment sent meanwhile", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "messages"`)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+		mock.ExpectExec(regexp.QuoteMeta(`UPDATE "message_attachments" SET "message_id"=$1,"updated_at"=$2 WHERE (id IN ($3,$4) AND message_id IS NULL)`)).
+			WithArgs(12, sqlmock.AnyArg(), 3, 4).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectRollback()
+
+		message, err := repo.SaveMessage(&models.Message{Content: "hello", ChannelId: 5, SenderId: 4}, []uint{3, 4})
+
+		assert.Nil(t, message)
+		assert.ErrorIs(t, err, chat.ErrAttachmentsTaken)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,54 @@
+package repository
+
+import "github.com/esgi-challenge/backend/internal/models"
+
+const (
+	lastMessagesQuery = `
+	SELECT DISTINCT ON (messages.channel_id)
+		messages.*
+	FROM
+		messages
+	WHERE
+		messages.deleted_at IS NULL
+		AND messages.channel_id IN ?
+	ORDER BY messages.channel_id, messages.id DESC
+	`
+
+	// Own and deleted messages are never unread
+	unreadCountsQuery = `
+	SELECT
+		messages.channel_id,
+		count(*) AS count
+	FROM
+		messages
+		JOIN channel_members ON channel_members.channel_id = messages.channel_id AND channel_members.user_id = @user
+	WHERE
+		messages.deleted_at IS NULL
+		AND messages.channel_id IN @channels
+		AND messages.sender_id <> @user
+		AND messages.removed_at IS NULL
+		AND messages.id > coalesce(channel_members.last_read_message_id, 0)
+	GROUP BY messages.channel_id
+	`
+
+	// Newest matches of the channels of the user, older than @before when it is not 0
+	searchMessagesQuery = `
+	SELECT
+		messages.*,
+		channels.kind AS channel_kind,
+		channels.name AS channel_name,
+		ts_headline('simple', messages.content, query, @options) AS snippet
+	FROM
+		messages
+		JOIN channels ON channels.id = messages.channel_id AND channels.deleted_at IS NULL
+		JOIN channel_members ON channel_members.channel_id = messages.channel_id AND channel_members.user_id = @user,
+		websearch_to_tsquery('simple', @query) AS query
+	WHERE
+		messages.deleted_at IS NULL
+		AND messages.removed_at IS NULL
+		AND ` + models.MessageSearchVector + ` @@ query
+		AND (@before = 0 OR messages.id < @before)
+	ORDER BY messages.id DESC
+	LIMIT @limit
+	`
+)
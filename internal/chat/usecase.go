@@ -1,14 +1,58 @@
 package chat
 
 import (
+	"net/http"
+
 	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
 )
 
+// The sockets of a user removed from the channel are closed when they get it
+var ErrNotMember = errorHandler.HttpError{
+	HttpStatus: http.StatusForbidden,
+	HttpError:  "You are not a member of this channel",
+}
+
 type UseCase interface {
 	Create(chat *models.Channel) (*models.Channel, error)
 	GetAllByUser(userId uint) (*[]models.Channel, error)
-	GetById(id uint) (*models.Channel, error)
-	SaveMessage(msg *models.Message) (*models.Message, error)
+	GetById(user *models.User, id uint) (*models.Channel, error)
+	GetMessages(user *models.User, channelId uint, before *uint, limit int) (*models.MessagePage, error)
+	// Full text search in the messages of the user channels
+	SearchMessages(user *models.User, query string, before *uint, limit int) (*models.MessageSearchPage, error)
+	// Save the message with the attachments uploaded by the user and publish it to the channel sockets
+	SendMessage(user *models.User, channelId uint, content string, attachmentIds []uint) (*models.Message, error)
+	UploadAttachment(user *models.User, channelId uint, attachment *models.MessageAttachmentCreate) (*models.MessageAttachment, error)
+	// Content of the attachment file, or of its thumbnail, for the channel members
+	GetAttachment(user *models.User, id uint, thumbnail bool) (*models.MessageAttachment, []byte, error)
+	PurgePendingAttachments() error
+	// Only by the sender during the edit window, the previous content is kept for the moderators
+	EditMessage(user *models.User, messageId uint, content string) (*models.Message, error)
+	DeleteMessage(user *models.User, messageId uint) (*models.Message, error)
+	GetMessageHistory(user *models.User, messageId uint) (*models.MessageHistory, error)
+	MarkRead(user *models.User, channelId uint, read *models.ChannelRead) error
+	GetUnread(user *models.User) (*models.UnreadSummary, error)
+	SendTyping(user *models.User, channelId uint, typing bool) error
+	PublishPresence(userId uint, online bool) error
 	GetAllPossibleChatStudent(user *models.User) (*[]models.User, error)
 	GetAllPossibleChatTeacher(user *models.User) (*[]models.User, error)
+	IsMember(channelId uint, userId uint) (bool, error)
+	ReportMessage(user *models.User, messageId uint, report *models.MessageReportCreate) (*models.MessageReport, error)
+	// Reports of the administrator school, of every school for the super administrators
+	GetReports(user *models.User, status models.MessageReportStatus) (*[]models.MessageReport, error)
+	ReviewReport(user *models.User, id uint, review *models.MessageReportReview) (*models.MessageReport, error)
+	BlockUser(user *models.User, blockedId uint) error
+	UnblockUser(user *models.User, blockedId uint) error
+	GetBlockedUsers(user *models.User) (*[]models.UserBlock, error)
+	MuteUser(user *models.User, mute *models.ChatMuteCreate) (*models.ChatMute, error)
+	GetMutes(user *models.User) (*[]models.ChatMute, error)
+	Unmute(user *models.User, id uint) error
+	// Provision the class channel and resync the course channels of its path
+	SyncClassChannels(classId uint) error
+	SyncPathChannels(pathId uint) error
+	SyncCourseChannel(courseId uint) error
+	SyncProjectGroupChannel(projectId uint, group uint) error
+	// Provision and resync every class, course and project group channel
+	SyncGroupChannels() error
+	DeleteChannels(kind models.ChannelKind, refId uint) error
 }
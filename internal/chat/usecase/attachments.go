@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+)
+
+// Attachments not sent with a message after this are removed with their files
+const attachmentExpiration = 24 * time.Hour
+
+func (u *chatUseCase) UploadAttachment(user *models.User, channelId uint, attachment *models.MessageAttachmentCreate) (*models.MessageAttachment, error) {
+	if err := u.checkMember(channelId, user.ID); err != nil {
+		return nil, err
+	}
+
+	if err := u.checkCanSend(user.ID, channelId); err != nil {
+		return nil, err
+	}
+
+	if len(attachment.Byte) == 0 {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The file is empty",
+		}
+	}
+
+	if len(attachment.Byte) > models.MessageAttachmentMaxSize {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusRequestEntityTooLarge,
+			HttpError:  "The file is too large",
+		}
+	}
+
+	contentType := http.DetectContentType(attachment.Byte)
+
+	if !slices.Contains(models.MessageAttachmentContentTypes, contentType) {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusUnsupportedMediaType,
+			HttpError:  "This file type is not allowed",
+		}
+	}
+
+	ctx := context.Background()
+
+	filename, err := u.storage.UploadAttachment(ctx, attachment.Byte, contentType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dbAttachment := &models.MessageAttachment{
+		ChannelId:   channelId,
+		UploaderId:  user.ID,
+		Name:        attachment.Name,
+		ContentType: contentType,
+		Size:        int64(len(attachment.Byte)),
+		Path:        filename,
+	}
+
+	// The attachment is still usable without its thumbnail
+	if strings.HasPrefix(contentType, "image/") {
+		thumbnail, err := u.previewer.Generate(ctx, contentType, attachment.Byte)
+
+		if err == nil {
+			dbAttachment.ThumbnailPath, err = u.storage.UploadAttachment(ctx, thumbnail, "image/jpeg")
+		}
+
+		if err != nil {
+			u.logger.Errorf("Chat: attachment thumbnail: %v", err)
+		}
+
+		dbAttachment.HasThumbnail = dbAttachment.ThumbnailPath != ""
+	}
+
+	return u.chatRepo.CreateAttachment(dbAttachment)
+}
+
+// The attachments must have been uploaded to the channel by the user and not be sent yet
+func (u *chatUseCase) checkAttachments(user *models.User, channelId uint, attachmentIds []uint) error {
+	if len(attachmentIds) == 0 {
+		return nil
+	}
+
+	if len(attachmentIds) > models.MessageAttachmentMaxCount {
+		return errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "Too many attachments",
+		}
+	}
+
+	attachments, err := u.chatRepo.GetAttachmentsByIds(attachmentIds)
+
+	if err != nil {
+		return err
+	}
+
+	valid := len(*attachments) == len(attachmentIds)
+
+	for _, attachment := range *attachments {
+		if attachment.ChannelId != channelId || attachment.UploaderId != user.ID || attachment.MessageId != nil {
+			valid = false
+		}
+	}
+
+	if !valid {
+		return errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "Invalid attachments",
+		}
+	}
+
+	return nil
+}
+
+func (u *chatUseCase) GetAttachment(user *models.User, id uint, thumbnail bool) (*models.MessageAttachment, []byte, error) {
+	attachment, err := u.chatRepo.GetAttachmentById(id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := u.checkMember(attachment.ChannelId, user.ID); err != nil {
+		return nil, nil, err
+	}
+
+	if attachment.MessageId == nil {
+		if attachment.UploaderId != user.ID {
+			return nil, nil, errorHandler.HttpError{
+				HttpStatus: http.StatusForbidden,
+				HttpError:  "This attachment has not been sent yet",
+			}
+		}
+	} else {
+		message, err := u.chatRepo.GetMessageById(*attachment.MessageId)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if message.RemovedAt != nil {
+			return nil, nil, errorHandler.HttpError{
+				HttpStatus: http.StatusNotFound,
+				HttpError:  "This message was deleted",
+			}
+		}
+	}
+
+	filename := attachment.Path
+
+	if thumbnail {
+		if !attachment.HasThumbnail {
+			return nil, nil, errorHandler.HttpError{
+				HttpStatus: http.StatusNotFound,
+				HttpError:  "This attachment has no thumbnail",
+			}
+		}
+
+		filename = attachment.ThumbnailPath
+	}
+
+	file, err := u.storage.ReadFile(context.Background(), filename)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, file, nil
+}
+
+func (u *chatUseCase) PurgePendingAttachments() error {
+	attachments, err := u.chatRepo.GetPendingAttachmentsBefore(time.Now().Add(-attachmentExpiration))
+
+	if err != nil {
+		return err
+	}
+
+	for _, attachment := range *attachments {
+		var errs []error
+
+		for _, filename := range []string{attachment.Path, attachment.ThumbnailPath} {
+			if filename != "" {
+				errs = append(errs, u.storage.DeleteFile(context.Background(), filename))
+			}
+		}
+
+		if err := errors.Join(errs...); err != nil {
+			u.logger.Errorf("Chat: attachment %d: %v", attachment.ID, err)
+			continue
+		}
+
+		if err := u.chatRepo.DeleteAttachment(attachment.ID); err != nil {
+			u.logger.Errorf("Chat: attachment %d: %v", attachment.ID, err)
+		}
+	}
+
+	return nil
+}
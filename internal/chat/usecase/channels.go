@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"gorm.io/gorm"
+)
+
+// Create the channel if needed, rename it and make its members match memberIds
+func (u *chatUseCase) syncChannel(ref *models.Channel, refId uint, memberIds []uint) error {
+	channel, err := u.chatRepo.GetByRef(ref.Kind, refId, ref.ProjectGroup)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		channel, err = u.chatRepo.Create(ref)
+	} else if err == nil && channel.Name != ref.Name {
+		channel.Name = ref.Name
+		channel, err = u.chatRepo.Update(channel)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	currentIds, err := u.chatRepo.GetMemberIds(channel.ID)
+
+	if err != nil {
+		return err
+	}
+
+	current := map[uint]bool{}
+	for _, id := range currentIds {
+		current[id] = true
+	}
+
+	wanted := map[uint]bool{}
+	var added []uint
+	for _, id := range memberIds {
+		wanted[id] = true
+
+		if !current[id] {
+			added = append(added, id)
+		}
+	}
+
+	var removed []uint
+	for _, id := range currentIds {
+		if !wanted[id] {
+			removed = append(removed, id)
+		}
+	}
+
+	if err := u.chatRepo.AddMembers(channel.ID, added); err != nil {
+		return err
+	}
+
+	return u.chatRepo.RemoveMembers(channel.ID, removed)
+}
+
+func (u *chatUseCase) SyncClassChannels(classId uint) error {
+	class, err := u.classRepo.GetById(classId)
+
+	if err != nil {
+		return err
+	}
+
+	if err := u.syncClassChannel(class); err != nil {
+		return err
+	}
+
+	return u.SyncPathChannels(class.PathId)
+}
+
+func (u *chatUseCase) syncClassChannel(class *models.Class) error {
+	memberIds, err := u.chatRepo.GetClassMemberIds(class.ID)
+
+	if err != nil {
+		return err
+	}
+
+	return u.syncChannel(&models.Channel{
+		Kind:    models.CHANNEL_CLASS,
+		Name:    class.Name,
+		ClassId: &class.ID,
+	}, class.ID, memberIds)
+}
+
+func (u *chatUseCase) SyncPathChannels(pathId uint) error {
+	courseIds, err := u.chatRepo.GetCourseIdsByPath(pathId)
+
+	if err != nil {
+		return err
+	}
+
+	for _, courseId := range courseIds {
+		if err := u.SyncCourseChannel(courseId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *chatUseCase) SyncCourseChannel(courseId uint) error {
+	course, err := u.courseRepo.GetById(courseId)
+
+	if err != nil {
+		return err
+	}
+
+	memberIds, err := u.chatRepo.GetCourseMemberIds(course)
+
+	if err != nil {
+		return err
+	}
+
+	return u.syncChannel(&models.Channel{
+		Kind:     models.CHANNEL_COURSE,
+		Name:     course.Name,
+		CourseId: &course.ID,
+	}, course.ID, memberIds)
+}
+
+func (u *chatUseCase) SyncProjectGroupChannel(projectId uint, group uint) error {
+	project, err := u.projectRepo.GetPreloadById(projectId)
+
+	if err != nil {
+		return err
+	}
+
+	// Deleted project
+	if project.ID == 0 {
+		return nil
+	}
+
+	memberIds, err := u.chatRepo.GetProjectGroupMemberIds(project.ID, group)
+
+	if err != nil {
+		return err
+	}
+
+	return u.syncChannel(&models.Channel{
+		Kind:         models.CHANNEL_PROJECT_GROUP,
+		Name:         fmt.Sprintf("%s - Group %d", project.Title, group),
+		ProjectId:    &project.ID,
+		ProjectGroup: &group,
+	}, project.ID, memberIds)
+}
+
+func (u *chatUseCase) SyncGroupChannels() error {
+	classes, err := u.classRepo.GetAll()
+
+	if err != nil {
+		return err
+	}
+
+	for _, class := range *classes {
+		if err := u.syncClassChannel(&class); err != nil {
+			return err
+		}
+	}
+
+	courses, err := u.courseRepo.GetAll()
+
+	if err != nil {
+		return err
+	}
+
+	for _, course := range *courses {
+		if err := u.SyncCourseChannel(course.ID); err != nil {
+			return err
+		}
+	}
+
+	groups, err := u.chatRepo.GetAllProjectGroups()
+
+	if err != nil {
+		return err
+	}
+
+	for _, group := range *groups {
+		if err := u.SyncProjectGroupChannel(group.ProjectId, group.Group); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *chatUseCase) DeleteChannels(kind models.ChannelKind, refId uint) error {
+	return u.chatRepo.DeleteByRef(kind, refId)
+}
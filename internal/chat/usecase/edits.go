@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+)
+
+// Message of the user that can still be edited or deleted
+func (u *chatUseCase) getOwnMessage(user *models.User, messageId uint) (*models.Message, error) {
+	message, err := u.chatRepo.GetMessageById(messageId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if message.SenderId != user.ID {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This message is not yours",
+		}
+	}
+
+	if err := u.checkMember(message.ChannelId, user.ID); err != nil {
+		return nil, err
+	}
+
+	if message.RemovedAt != nil {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusConflict,
+			HttpError:  "This message was deleted",
+		}
+	}
+
+	if time.Since(message.CreatedAt) > u.cfg.ChatEditWindow {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This message can't be changed anymore",
+		}
+	}
+
+	return message, nil
+}
+
+func (u *chatUseCase) EditMessage(user *models.User, messageId uint, content string) (*models.Message, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The message is empty",
+		}
+	}
+
+	if err := u.checkNotMuted(user.ID); err != nil {
+		return nil, err
+	}
+
+	message, err := u.getOwnMessage(user, messageId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if message.Content == content {
+		return message, nil
+	}
+
+	revision := &models.MessageRevision{
+		MessageId: message.ID,
+		Content:   message.Content,
+	}
+
+	now := time.Now()
+	message.Content = content
+	message.EditedAt = &now
+
+	message, err = u.chatRepo.UpdateMessage(message, revision)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.publish(&models.ChatEvent{
+		Type:      models.CHAT_EVENT_EDIT,
+		ChannelId: message.ChannelId,
+		Id:        message.ID,
+		Content:   message.Content,
+		SenderId:  message.SenderId,
+		CreatedAt: &message.CreatedAt,
+		EditedAt:  message.EditedAt,
+	})
+
+	return message, nil
+}
+
+// Replace the message by a tombstone, its content is only kept in the revisions
+func (u *chatUseCase) DeleteMessage(user *models.User, messageId uint) (*models.Message, error) {
+	message, err := u.getOwnMessage(user, messageId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return u.removeMessage(message)
+}
+
+func (u *chatUseCase) removeMessage(message *models.Message) (*models.Message, error) {
+	revision := &models.MessageRevision{
+		MessageId: message.ID,
+		Content:   message.Content,
+	}
+
+	now := time.Now()
+	message.Content = models.MessageDeletedContent
+	message.RemovedAt = &now
+
+	message, err := u.chatRepo.UpdateMessage(message, revision)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.publish(&models.ChatEvent{
+		Type:      models.CHAT_EVENT_DELETE,
+		ChannelId: message.ChannelId,
+		Id:        message.ID,
+		Content:   message.Content,
+		SenderId:  message.SenderId,
+		CreatedAt: &message.CreatedAt,
+	})
+
+	return message, nil
+}
+
+// Administrators can only see the history of messages sent by users of their school
+func (u *chatUseCase) GetMessageHistory(user *models.User, messageId uint) (*models.MessageHistory, error) {
+	message, err := u.chatRepo.GetMessageWithSender(messageId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schoolId, err := u.moderatedSchool(user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if schoolId != nil && (message.Sender == nil || message.Sender.SchoolId == nil || *message.Sender.SchoolId != *schoolId) {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This message is not from your school",
+		}
+	}
+
+	revisions, err := u.chatRepo.GetMessageRevisions(message.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.MessageHistory{
+		Message:   *message,
+		Revisions: *revisions,
+	}, nil
+}
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/chat/mock"
+	"github.com/esgi-challenge/backend/internal/models"
+	schoolMock "github.com/esgi-challenge/backend/internal/school/mock"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/esgi-challenge/backend/pkg/realtime"
+	"github.com/esgi-challenge/backend/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func sentMessage(age time.Duration) *models.Message {
+	return &models.Message{
+		GormModel: models.GormModel{ID: 10, CreatedAt: time.Now().Add(-age)},
+		Content:   "hello",
+		ChannelId: 5,
+		SenderId:  1,
+	}
+}
+
+func TestEditMessage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+	cfg := &config.Config{ChatEditWindow: 15 * time.Minute}
+
+	useCase := NewChatUseCase(cfg, mockChatRepo, mockSchoolRepo, nil, nil, nil, nil, nil, realtime.NewLocalHub(), storage.Storage{}, nil, logger)
+
+	sender := &models.User{GormModel: models.GormModel{ID: 1}}
+
+	t.Run("success", func(t *testing.T) {
+		mockChatRepo.EXPECT().GetActiveMute(sender.ID).Return(nil, gorm.ErrRecordNotFound)
+		mockChatRepo.EXPECT().GetMessageById(uint(10)).Return(sentMessage(time.Minute), nil)
+		mockChatRepo.EXPECT().IsMember(uint(5), sender.ID).Return(true, nil)
+		mockChatRepo.EXPECT().UpdateMessage(gomock.Any(), gomock.Any()).DoAndReturn(func(message *models.Message, revision *models.MessageRevision) (*models.Message, error) {
+			assert.Equal(t, "hello", revision.Content)
+			assert.Equal(t, message.ID, revision.MessageId)
+			return message, nil
+		})
+
+		message, err := useCase.EditMessage(sender, 10, "hello again")
+		assert.NoError(t, err)
+		assert.Equal(t, "hello again", message.Content)
+		assert.NotNil(t, message.EditedAt)
+	})
+
+	t.Run("not the sender", func(t *testing.T) {
+		other := &models.User{GormModel: models.GormModel{ID: 2}}
+
+		mockChatRepo.EXPECT().GetActiveMute(other.ID).Return(nil, gorm.ErrRecordNotFound)
+		mockChatRepo.EXPECT().GetMessageById(uint(10)).Return(sentMessage(time.Minute), nil)
+
+		message, err := useCase.EditMessage(other, 10, "hello again")
+		assert.Nil(t, message)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This message is not yours",
+		}, err)
+	})
+
+	t.Run("edit window over", func(t *testing.T) {
+		mockChatRepo.EXPECT().GetActiveMute(sender.ID).Return(nil, gorm.ErrRecordNotFound)
+		mockChatRepo.EXPECT().GetMessageById(uint(10)).Return(sentMessage(time.Hour), nil)
+		mockChatRepo.EXPECT().IsMember(uint(5), sender.ID).Return(true, nil)
+
+		message, err := useCase.EditMessage(sender, 10, "hello again")
+		assert.Nil(t, message)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This message can't be changed anymore",
+		}, err)
+	})
+
+	t.Run("tombstone", func(t *testing.T) {
+		removed := sentMessage(time.Minute)
+		removedAt := time.Now()
+		removed.RemovedAt = &removedAt
+
+		mockChatRepo.EXPECT().GetActiveMute(sender.ID).Return(nil, gorm.ErrRecordNotFound)
+		mockChatRepo.EXPECT().GetMessageById(uint(10)).Return(removed, nil)
+		mockChatRepo.EXPECT().IsMember(uint(5), sender.ID).Return(true, nil)
+
+		message, err := useCase.EditMessage(sender, 10, "hello again")
+		assert.Nil(t, message)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusConflict,
+			HttpError:  "This message was deleted",
+		}, err)
+	})
+
+	t.Run("empty content", func(t *testing.T) {
+		message, err := useCase.EditMessage(sender, 10, "  ")
+		assert.Nil(t, message)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The message is empty",
+		}, err)
+	})
+}
+
+func TestDeleteMessage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+	cfg := &config.Config{ChatEditWindow: 15 * time.Minute}
+
+	useCase := NewChatUseCase(cfg, mockChatRepo, mockSchoolRepo, nil, nil, nil, nil, nil, realtime.NewLocalHub(), storage.Storage{}, nil, logger)
+
+	sender := &models.User{GormModel: models.GormModel{ID: 1}}
+
+	t.Run("tombstone keeps the content in the revisions", func(t *testing.T) {
+		mockChatRepo.EXPECT().GetMessageById(uint(10)).Return(sentMessage(time.Minute), nil)
+		mockChatRepo.EXPECT().IsMember(uint(5), sender.ID).Return(true, nil)
+		mockChatRepo.EXPECT().UpdateMessage(gomock.Any(), gomock.Any()).DoAndReturn(func(message *models.Message, revision *models.MessageRevision) (*models.Message, error) {
+			assert.Equal(t, "hello", revision.Content)
+			return message, nil
+		})
+
+		message, err := useCase.DeleteMessage(sender, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, models.MessageDeletedContent, message.Content)
+		assert.NotNil(t, message.RemovedAt)
+	})
+
+	t.Run("left the channel", func(t *testing.T) {
+		mockChatRepo.EXPECT().GetMessageById(uint(10)).Return(sentMessage(time.Minute), nil)
+		mockChatRepo.EXPECT().IsMember(uint(5), sender.ID).Return(false, nil)
+
+		message, err := useCase.DeleteMessage(sender, 10)
+		assert.Nil(t, message)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "You are not a member of this channel",
+		}, err)
+	})
+}
+
+func TestGetMessageHistory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewChatUseCase(nil, mockChatRepo, mockSchoolRepo, nil, nil, nil, nil, nil, realtime.NewLocalHub(), storage.Storage{}, nil, logger)
+
+	schoolId := uint(3)
+	message := &models.Message{
+		GormModel: models.GormModel{ID: 10},
+		Content:   models.MessageDeletedContent,
+		Sender:    &models.User{GormModel: models.GormModel{ID: 1}, SchoolId: &schoolId},
+	}
+	revisions := &[]models.MessageRevision{{MessageId: 10, Content: "hello"}}
+
+	t.Run("administrator of the school", func(t *testing.T) {
+		admin := &models.User{GormModel: models.GormModel{ID: 7}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+
+		mockChatRepo.EXPECT().GetMessageWithSender(uint(10)).Return(message, nil)
+		mockSchoolRepo.EXPECT().GetByUser(admin).Return(&models.School{GormModel: models.GormModel{ID: 3}}, nil)
+		mockChatRepo.EXPECT().GetMessageRevisions(uint(10)).Return(revisions, nil)
+
+		history, err := useCase.GetMessageHistory(admin, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, *revisions, history.Revisions)
+	})
+
+	t.Run("administrator of another school", func(t *testing.T) {
+		admin := &models.User{GormModel: models.GormModel{ID: 8}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+
+		mockChatRepo.EXPECT().GetMessageWithSender(uint(10)).Return(message, nil)
+		mockSchoolRepo.EXPECT().GetByUser(admin).Return(&models.School{GormModel: models.GormModel{ID: 4}}, nil)
+
+		history, err := useCase.GetMessageHistory(admin, 10)
+		assert.Nil(t, history)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This message is not from your school",
+		}, err)
+	})
+
+	t.Run("super administrator", func(t *testing.T) {
+		superAdmin := &models.User{GormModel: models.GormModel{ID: 9}, UserKind: models.NewUserKind(models.SUPERADMIN)}
+
+		mockChatRepo.EXPECT().GetMessageWithSender(uint(10)).Return(message, nil)
+		mockChatRepo.EXPECT().GetMessageRevisions(uint(10)).Return(revisions, nil)
+
+		history, err := useCase.GetMessageHistory(superAdmin, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, *revisions, history.Revisions)
+	})
+}
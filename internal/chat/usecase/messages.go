@@ -0,0 +1,231 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/esgi-challenge/backend/internal/chat"
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+)
+
+func (u *chatUseCase) publish(event *models.ChatEvent) {
+	payload, err := json.Marshal(event)
+
+	if err != nil {
+		u.logger.Errorf("Chat: channel %d event: %v", event.ChannelId, err)
+		return
+	}
+
+	u.hub.Publish(models.ChatChannelTopic(event.ChannelId), payload)
+}
+
+func (u *chatUseCase) SendMessage(user *models.User, channelId uint, content string, attachmentIds []uint) (*models.Message, error) {
+	if err := u.checkMember(channelId, user.ID); err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(content) == "" && len(attachmentIds) == 0 {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The message is empty",
+		}
+	}
+
+	if err := u.checkCanSend(user.ID, channelId); err != nil {
+		return nil, err
+	}
+
+	if err := u.checkAttachments(user, channelId, attachmentIds); err != nil {
+		return nil, err
+	}
+
+	message, err := u.chatRepo.SaveMessage(&models.Message{
+		Content:   content,
+		ChannelId: channelId,
+		SenderId:  user.ID,
+	}, attachmentIds)
+
+	// Sent with another message since they were checked
+	if errors.Is(err, chat.ErrAttachmentsTaken) {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusConflict,
+			HttpError:  "An attachment was already sent",
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The sender has read the channel up to its own message
+	if _, err := u.chatRepo.MarkRead(channelId, user.ID, message.ID); err != nil {
+		u.logger.Errorf("Chat: channel %d read: %v", channelId, err)
+	}
+
+	u.publish(&models.ChatEvent{
+		Type:        models.CHAT_EVENT_MESSAGE,
+		ChannelId:   message.ChannelId,
+		Id:          message.ID,
+		Content:     message.Content,
+		SenderId:    message.SenderId,
+		CreatedAt:   &message.CreatedAt,
+		Attachments: message.Attachments,
+	})
+
+	u.notifyMessage(user, message)
+
+	return message, nil
+}
+
+// Members without an open socket get the message on their devices or by email
+func (u *chatUseCase) notifyMessage(sender *models.User, message *models.Message) {
+	memberIds, err := u.chatRepo.GetMemberIds(message.ChannelId)
+	if err != nil {
+		u.logger.Errorf("Chat: channel %d members: %v", message.ChannelId, err)
+		return
+	}
+
+	recipients := make([]uint, 0, len(memberIds))
+	for _, id := range memberIds {
+		if id != sender.ID {
+			recipients = append(recipients, id)
+		}
+	}
+
+	key := models.NOTIFICATION_MESSAGE_CHAT_MESSAGE
+	if message.Content == "" {
+		key = models.NOTIFICATION_MESSAGE_CHAT_ATTACHMENT
+	}
+
+	err = u.notificationUseCase.NotifyChatMessage(recipients, &models.Notification{
+		MessageKey: key,
+		MessageParams: map[string]string{
+			"sender":  fmt.Sprintf("%s %s", sender.Firstname, sender.Lastname),
+			"content": message.Content,
+		},
+	}, map[string]string{
+		"type":      "chat",
+		"channelId": strconv.FormatUint(uint64(message.ChannelId), 10),
+		"messageId": strconv.FormatUint(uint64(message.ID), 10),
+	})
+
+	if err != nil {
+		u.logger.Errorf("Chat: channel %d notification: %v", message.ChannelId, err)
+	}
+}
+
+func (u *chatUseCase) MarkRead(user *models.User, channelId uint, read *models.ChannelRead) error {
+	if err := u.checkMember(channelId, user.ID); err != nil {
+		return err
+	}
+
+	var message *models.Message
+
+	if read.MessageId != nil {
+		var err error
+		message, err = u.chatRepo.GetMessageById(*read.MessageId)
+
+		if err != nil {
+			return err
+		}
+
+		if message.ChannelId != channelId {
+			return errorHandler.HttpError{
+				HttpStatus: http.StatusBadRequest,
+				HttpError:  "This message is not from this channel",
+			}
+		}
+	} else {
+		messages, err := u.chatRepo.GetMessages(channelId, nil, 1)
+
+		if err != nil {
+			return err
+		}
+
+		// Nothing to read
+		if len(*messages) == 0 {
+			return nil
+		}
+
+		message = &(*messages)[0]
+	}
+
+	updated, err := u.chatRepo.MarkRead(channelId, user.ID, message.ID)
+
+	if err != nil {
+		return err
+	}
+
+	if updated {
+		u.publish(&models.ChatEvent{
+			Type:      models.CHAT_EVENT_READ,
+			ChannelId: channelId,
+			UserId:    user.ID,
+			MessageId: message.ID,
+		})
+	}
+
+	return nil
+}
+
+func (u *chatUseCase) GetUnread(user *models.User) (*models.UnreadSummary, error) {
+	channelIds, err := u.chatRepo.GetMemberChannelIds(user.ID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	unreads, err := u.chatRepo.GetUnreadCounts(user.ID, channelIds)
+
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &models.UnreadSummary{Channels: *unreads}
+
+	for _, unread := range *unreads {
+		summary.Total += unread.Count
+	}
+
+	return summary, nil
+}
+
+func (u *chatUseCase) SendTyping(user *models.User, channelId uint, typing bool) error {
+	if err := u.checkMember(channelId, user.ID); err != nil {
+		return err
+	}
+
+	u.publish(&models.ChatEvent{
+		Type:      models.CHAT_EVENT_TYPING,
+		ChannelId: channelId,
+		UserId:    user.ID,
+		Typing:    &typing,
+	})
+
+	return nil
+}
+
+// Tell every channel of the user that it is now online or offline
+func (u *chatUseCase) PublishPresence(userId uint, online bool) error {
+	channelIds, err := u.chatRepo.GetMemberChannelIds(userId)
+
+	if err != nil {
+		return err
+	}
+
+	for _, channelId := range channelIds {
+		u.publish(&models.ChatEvent{
+			Type:      models.CHAT_EVENT_PRESENCE,
+			ChannelId: channelId,
+			UserId:    userId,
+			Online:    &online,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"gorm.io/gorm"
+)
+
+// School moderated by the user, nil for the super administrators who moderate every school
+func (u *chatUseCase) moderatedSchool(user *models.User) (*uint, error) {
+	if *user.UserKind == models.SUPERADMIN {
+		return nil, nil
+	}
+
+	school, err := u.schoolRepo.GetByUser(user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &school.ID, nil
+}
+
+func (u *chatUseCase) checkNotMuted(userId uint) error {
+	mute, err := u.chatRepo.GetActiveMute(userId)
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return errorHandler.HttpError{
+		HttpStatus: http.StatusForbidden,
+		HttpError:  fmt.Sprintf("You are muted until %s", mute.Until.Format(time.RFC3339)),
+	}
+}
+
+// Muted users can't send anything and direct channels are closed once a user blocked the other
+func (u *chatUseCase) checkCanSend(userId uint, channelId uint) error {
+	if err := u.checkNotMuted(userId); err != nil {
+		return err
+	}
+
+	blocked, err := u.chatRepo.IsDirectChannelBlocked(channelId)
+
+	if err != nil {
+		return err
+	}
+
+	if blocked {
+		return errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "You can't message this user",
+		}
+	}
+
+	return nil
+}
+
+func (u *chatUseCase) ReportMessage(user *models.User, messageId uint, report *models.MessageReportCreate) (*models.MessageReport, error) {
+	message, err := u.chatRepo.GetMessageById(messageId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.checkMember(message.ChannelId, user.ID); err != nil {
+		return nil, err
+	}
+
+	if message.SenderId == user.ID {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "You can't report your own message",
+		}
+	}
+
+	school, err := u.schoolRepo.GetByUser(user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dbReport, err := u.chatRepo.CreateReport(&models.MessageReport{
+		MessageId:  message.ID,
+		ReporterId: user.ID,
+		SchoolId:   school.ID,
+		Reason:     report.Reason,
+		Status:     models.MESSAGE_REPORT_PENDING,
+	})
+
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusConflict,
+			HttpError:  "You already reported this message",
+		}
+	}
+
+	return dbReport, err
+}
+
+func (u *chatUseCase) GetReports(user *models.User, status models.MessageReportStatus) (*[]models.MessageReport, error) {
+	schoolId, err := u.moderatedSchool(user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return u.chatRepo.GetReports(schoolId, status)
+}
+
+func (u *chatUseCase) ReviewReport(user *models.User, id uint, review *models.MessageReportReview) (*models.MessageReport, error) {
+	if review.Status != models.MESSAGE_REPORT_DISMISSED && review.Status != models.MESSAGE_REPORT_ACTIONED {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "A report can only be dismissed or actioned",
+		}
+	}
+
+	report, err := u.chatRepo.GetReportById(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schoolId, err := u.moderatedSchool(user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if schoolId != nil && report.SchoolId != *schoolId {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This report is not from your school",
+		}
+	}
+
+	if review.DeleteMessage && report.Message.RemovedAt == nil {
+		message, err := u.removeMessage(&report.Message)
+
+		if err != nil {
+			return nil, err
+		}
+
+		report.Message = *message
+	}
+
+	now := time.Now()
+	report.Status = review.Status
+	report.ReviewerId = &user.ID
+	report.ReviewedAt = &now
+
+	return u.chatRepo.UpdateReport(report)
+}
+
+func (u *chatUseCase) BlockUser(user *models.User, blockedId uint) error {
+	if blockedId == user.ID {
+		return errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "You can't block yourself",
+		}
+	}
+
+	if _, err := u.userRepo.GetById(blockedId); err != nil {
+		return err
+	}
+
+	return u.chatRepo.Block(&models.UserBlock{
+		BlockerId: user.ID,
+		BlockedId: blockedId,
+	})
+}
+
+func (u *chatUseCase) UnblockUser(user *models.User, blockedId uint) error {
+	return u.chatRepo.Unblock(user.ID, blockedId)
+}
+
+func (u *chatUseCase) GetBlockedUsers(user *models.User) (*[]models.UserBlock, error) {
+	return u.chatRepo.GetBlocks(user.ID)
+}
+
+func (u *chatUseCase) MuteUser(user *models.User, mute *models.ChatMuteCreate) (*models.ChatMute, error) {
+	if !mute.Until.After(time.Now()) {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The mute must end in the future",
+		}
+	}
+
+	muted, err := u.userRepo.GetById(mute.UserId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if *muted.UserKind >= models.ADMINISTRATOR {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "Administrators can't be muted",
+		}
+	}
+
+	schoolId, err := u.moderatedSchool(user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if schoolId != nil && (muted.SchoolId == nil || *muted.SchoolId != *schoolId) {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This user is not from your school",
+		}
+	}
+
+	dbMute := &models.ChatMute{
+		UserId:    muted.ID,
+		MutedById: user.ID,
+		Reason:    mute.Reason,
+		Until:     mute.Until,
+	}
+
+	if muted.SchoolId != nil {
+		dbMute.SchoolId = *muted.SchoolId
+	}
+
+	return u.chatRepo.CreateMute(dbMute)
+}
+
+func (u *chatUseCase) GetMutes(user *models.User) (*[]models.ChatMute, error) {
+	schoolId, err := u.moderatedSchool(user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return u.chatRepo.GetActiveMutes(schoolId)
+}
+
+func (u *chatUseCase) Unmute(user *models.User, id uint) error {
+	mute, err := u.chatRepo.GetMuteById(id)
+
+	if err != nil {
+		return err
+	}
+
+	schoolId, err := u.moderatedSchool(user)
+
+	if err != nil {
+		return err
+	}
+
+	if schoolId != nil && mute.SchoolId != *schoolId {
+		return errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This mute is not from your school",
+		}
+	}
+
+	return u.chatRepo.DeleteMute(mute.ID)
+}
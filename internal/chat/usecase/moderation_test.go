@@ -0,0 +1,311 @@
+package usecase
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/chat/mock"
+	"github.com/esgi-challenge/backend/internal/models"
+	schoolMock "github.com/esgi-challenge/backend/internal/school/mock"
+	userMock "github.com/esgi-challenge/backend/internal/user/mock"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/esgi-challenge/backend/pkg/realtime"
+	"github.com/esgi-challenge/backend/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestReportMessage(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewChatUseCase(nil, mockChatRepo, mockSchoolRepo, nil, nil, nil, nil, nil, realtime.NewLocalHub(), storage.Storage{}, nil, logger)
+
+	user := &models.User{GormModel: models.GormModel{ID: 1}, UserKind: models.NewUserKind(models.STUDENT)}
+	report := &models.MessageReportCreate{Reason: "spam"}
+	message := &models.Message{GormModel: models.GormModel{ID: 10}, ChannelId: 5, SenderId: 2}
+
+	t.Run("success", func(t *testing.T) {
+		mockChatRepo.EXPECT().GetMessageById(message.ID).Return(message, nil)
+		mockChatRepo.EXPECT().IsMember(message.ChannelId, user.ID).Return(true, nil)
+		mockSchoolRepo.EXPECT().GetByUser(user).Return(&models.School{GormModel: models.GormModel{ID: 3}}, nil)
+		mockChatRepo.EXPECT().CreateReport(gomock.Any()).DoAndReturn(func(report *models.MessageReport) (*models.MessageReport, error) {
+			return report, nil
+		})
+
+		created, err := useCase.ReportMessage(user, message.ID, report)
+		assert.NoError(t, err)
+		assert.Equal(t, models.MESSAGE_REPORT_PENDING, created.Status)
+		assert.Equal(t, uint(3), created.SchoolId)
+		assert.Equal(t, user.ID, created.ReporterId)
+	})
+
+	t.Run("own message", func(t *testing.T) {
+		own := &models.Message{GormModel: models.GormModel{ID: 11}, ChannelId: 5, SenderId: user.ID}
+
+		mockChatRepo.EXPECT().GetMessageById(own.ID).Return(own, nil)
+		mockChatRepo.EXPECT().IsMember(own.ChannelId, user.ID).Return(true, nil)
+
+		created, err := useCase.ReportMessage(user, own.ID, report)
+		assert.Nil(t, created)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "You can't report your own message",
+		}, err)
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		mockChatRepo.EXPECT().GetMessageById(message.ID).Return(message, nil)
+		mockChatRepo.EXPECT().IsMember(message.ChannelId, user.ID).Return(false, nil)
+
+		created, err := useCase.ReportMessage(user, message.ID, report)
+		assert.Nil(t, created)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "You are not a member of this channel",
+		}, err)
+	})
+
+	t.Run("already reported", func(t *testing.T) {
+		mockChatRepo.EXPECT().GetMessageById(message.ID).Return(message, nil)
+		mockChatRepo.EXPECT().IsMember(message.ChannelId, user.ID).Return(true, nil)
+		mockSchoolRepo.EXPECT().GetByUser(user).Return(&models.School{GormModel: models.GormModel{ID: 3}}, nil)
+		mockChatRepo.EXPECT().CreateReport(gomock.Any()).Return(nil, gorm.ErrDuplicatedKey)
+
+		created, err := useCase.ReportMessage(user, message.ID, report)
+		assert.Nil(t, created)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusConflict,
+			HttpError:  "You already reported this message",
+		}, err)
+	})
+}
+
+func TestReviewReport(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewChatUseCase(nil, mockChatRepo, mockSchoolRepo, nil, nil, nil, nil, nil, realtime.NewLocalHub(), storage.Storage{}, nil, logger)
+
+	admin := &models.User{GormModel: models.GormModel{ID: 1}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+	school := &models.School{GormModel: models.GormModel{ID: 3}}
+
+	// Reviewing changes the report so every case starts from a pending one
+	pendingReport := func() *models.MessageReport {
+		return &models.MessageReport{
+			GormModel: models.GormModel{ID: 7},
+			MessageId: 10,
+			Message:   models.Message{GormModel: models.GormModel{ID: 10}, ChannelId: 5, Content: "insult"},
+			SchoolId:  school.ID,
+			Status:    models.MESSAGE_REPORT_PENDING,
+		}
+	}
+
+	t.Run("dismiss", func(t *testing.T) {
+		report := pendingReport()
+
+		mockChatRepo.EXPECT().GetReportById(report.ID).Return(report, nil)
+		mockSchoolRepo.EXPECT().GetByUser(admin).Return(school, nil)
+		mockChatRepo.EXPECT().UpdateReport(report).Return(report, nil)
+
+		reviewed, err := useCase.ReviewReport(admin, report.ID, &models.MessageReportReview{Status: models.MESSAGE_REPORT_DISMISSED})
+		assert.NoError(t, err)
+		assert.Equal(t, models.MESSAGE_REPORT_DISMISSED, reviewed.Status)
+		assert.Equal(t, &admin.ID, reviewed.ReviewerId)
+		assert.NotNil(t, reviewed.ReviewedAt)
+		assert.Equal(t, "insult", reviewed.Message.Content)
+	})
+
+	t.Run("action and delete the message", func(t *testing.T) {
+		report := pendingReport()
+
+		mockChatRepo.EXPECT().GetReportById(report.ID).Return(report, nil)
+		mockSchoolRepo.EXPECT().GetByUser(admin).Return(school, nil)
+		mockChatRepo.EXPECT().UpdateMessage(gomock.Any(), gomock.Any()).DoAndReturn(func(message *models.Message, revision *models.MessageRevision) (*models.Message, error) {
+			assert.Equal(t, "insult", revision.Content)
+			return message, nil
+		})
+		mockChatRepo.EXPECT().UpdateReport(report).Return(report, nil)
+
+		reviewed, err := useCase.ReviewReport(admin, report.ID, &models.MessageReportReview{Status: models.MESSAGE_REPORT_ACTIONED, DeleteMessage: true})
+		assert.NoError(t, err)
+		assert.Equal(t, models.MESSAGE_REPORT_ACTIONED, reviewed.Status)
+		assert.Equal(t, models.MessageDeletedContent, reviewed.Message.Content)
+		assert.NotNil(t, reviewed.Message.RemovedAt)
+	})
+
+	t.Run("message already deleted", func(t *testing.T) {
+		report := pendingReport()
+		removedAt := time.Now()
+		report.Message.RemovedAt = &removedAt
+
+		mockChatRepo.EXPECT().GetReportById(report.ID).Return(report, nil)
+		mockSchoolRepo.EXPECT().GetByUser(admin).Return(school, nil)
+		mockChatRepo.EXPECT().UpdateReport(report).Return(report, nil)
+
+		_, err := useCase.ReviewReport(admin, report.ID, &models.MessageReportReview{Status: models.MESSAGE_REPORT_ACTIONED, DeleteMessage: true})
+		assert.NoError(t, err)
+	})
+
+	t.Run("super administrator reviews every school", func(t *testing.T) {
+		report := pendingReport()
+		superAdmin := &models.User{GormModel: models.GormModel{ID: 2}, UserKind: models.NewUserKind(models.SUPERADMIN)}
+
+		mockChatRepo.EXPECT().GetReportById(report.ID).Return(report, nil)
+		mockChatRepo.EXPECT().UpdateReport(report).Return(report, nil)
+
+		_, err := useCase.ReviewReport(superAdmin, report.ID, &models.MessageReportReview{Status: models.MESSAGE_REPORT_DISMISSED})
+		assert.NoError(t, err)
+	})
+
+	t.Run("other school", func(t *testing.T) {
+		report := pendingReport()
+		report.SchoolId = 4
+
+		mockChatRepo.EXPECT().GetReportById(report.ID).Return(report, nil)
+		mockSchoolRepo.EXPECT().GetByUser(admin).Return(school, nil)
+
+		reviewed, err := useCase.ReviewReport(admin, report.ID, &models.MessageReportReview{Status: models.MESSAGE_REPORT_ACTIONED, DeleteMessage: true})
+		assert.Nil(t, reviewed)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This report is not from your school",
+		}, err)
+	})
+
+	t.Run("back to pending", func(t *testing.T) {
+		reviewed, err := useCase.ReviewReport(admin, 7, &models.MessageReportReview{Status: models.MESSAGE_REPORT_PENDING})
+		assert.Nil(t, reviewed)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "A report can only be dismissed or actioned",
+		}, err)
+	})
+}
+
+func TestMuteUser(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	mockUserRepo := userMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewChatUseCase(nil, mockChatRepo, mockSchoolRepo, nil, nil, nil, mockUserRepo, nil, realtime.NewLocalHub(), storage.Storage{}, nil, logger)
+
+	admin := &models.User{GormModel: models.GormModel{ID: 1}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+	school := &models.School{GormModel: models.GormModel{ID: 3}}
+	until := time.Now().Add(time.Hour)
+
+	t.Run("success", func(t *testing.T) {
+		student := &models.User{GormModel: models.GormModel{ID: 2}, UserKind: models.NewUserKind(models.STUDENT), SchoolId: &school.ID}
+
+		mockUserRepo.EXPECT().GetById(student.ID).Return(student, nil)
+		mockSchoolRepo.EXPECT().GetByUser(admin).Return(school, nil)
+		mockChatRepo.EXPECT().CreateMute(gomock.Any()).DoAndReturn(func(mute *models.ChatMute) (*models.ChatMute, error) {
+			return mute, nil
+		})
+
+		mute, err := useCase.MuteUser(admin, &models.ChatMuteCreate{UserId: student.ID, Reason: "spam", Until: until})
+		assert.NoError(t, err)
+		assert.Equal(t, student.ID, mute.UserId)
+		assert.Equal(t, school.ID, mute.SchoolId)
+		assert.Equal(t, admin.ID, mute.MutedById)
+	})
+
+	t.Run("in the past", func(t *testing.T) {
+		mute, err := useCase.MuteUser(admin, &models.ChatMuteCreate{UserId: 2, Until: time.Now().Add(-time.Hour)})
+		assert.Nil(t, mute)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The mute must end in the future",
+		}, err)
+	})
+
+	t.Run("administrator", func(t *testing.T) {
+		other := &models.User{GormModel: models.GormModel{ID: 2}, UserKind: models.NewUserKind(models.ADMINISTRATOR), SchoolId: &school.ID}
+
+		mockUserRepo.EXPECT().GetById(other.ID).Return(other, nil)
+
+		mute, err := useCase.MuteUser(admin, &models.ChatMuteCreate{UserId: other.ID, Until: until})
+		assert.Nil(t, mute)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "Administrators can't be muted",
+		}, err)
+	})
+
+	t.Run("other school", func(t *testing.T) {
+		otherSchoolId := uint(4)
+		student := &models.User{GormModel: models.GormModel{ID: 2}, UserKind: models.NewUserKind(models.STUDENT), SchoolId: &otherSchoolId}
+
+		mockUserRepo.EXPECT().GetById(student.ID).Return(student, nil)
+		mockSchoolRepo.EXPECT().GetByUser(admin).Return(school, nil)
+
+		mute, err := useCase.MuteUser(admin, &models.ChatMuteCreate{UserId: student.ID, Until: until})
+		assert.Nil(t, mute)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This user is not from your school",
+		}, err)
+	})
+}
+
+func TestCheckCanSend(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatRepo := mock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewChatUseCase(nil, mockChatRepo, nil, nil, nil, nil, nil, nil, realtime.NewLocalHub(), storage.Storage{}, nil, logger).(*chatUseCase)
+
+	t.Run("allowed", func(t *testing.T) {
+		mockChatRepo.EXPECT().GetActiveMute(uint(1)).Return(nil, gorm.ErrRecordNotFound)
+		mockChatRepo.EXPECT().IsDirectChannelBlocked(uint(5)).Return(false, nil)
+
+		assert.NoError(t, useCase.checkCanSend(1, 5))
+	})
+
+	t.Run("muted", func(t *testing.T) {
+		until := time.Now().Add(time.Hour)
+
+		mockChatRepo.EXPECT().GetActiveMute(uint(1)).Return(&models.ChatMute{Until: until}, nil)
+
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  fmt.Sprintf("You are muted until %s", until.Format(time.RFC3339)),
+		}, useCase.checkCanSend(1, 5))
+	})
+
+	t.Run("blocked", func(t *testing.T) {
+		mockChatRepo.EXPECT().GetActiveMute(uint(1)).Return(nil, gorm.ErrRecordNotFound)
+		mockChatRepo.EXPECT().IsDirectChannelBlocked(uint(5)).Return(true, nil)
+
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "You can't message this user",
+		}, useCase.checkCanSend(1, 5))
+	})
+}
@@ -1,38 +1,265 @@
 package usecase
 
 import (
+	"html"
+	"net/http"
+	"slices"
+	"strings"
+
 	"github.com/esgi-challenge/backend/config"
 	"github.com/esgi-challenge/backend/internal/chat"
+	"github.com/esgi-challenge/backend/internal/class"
+	"github.com/esgi-challenge/backend/internal/course"
 	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/notification"
+	"github.com/esgi-challenge/backend/internal/project"
 	"github.com/esgi-challenge/backend/internal/school"
+	"github.com/esgi-challenge/backend/internal/user"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
 	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/esgi-challenge/backend/pkg/preview"
+	"github.com/esgi-challenge/backend/pkg/realtime"
+	"github.com/esgi-challenge/backend/pkg/storage"
 )
 
-type chatUseCase struct {
-	chatRepo   chat.Repository
-	schoolRepo school.Repository
-	cfg        *config.Config
-	logger     logger.Logger
-}
+const (
+	defaultHistoryLimit = 50
+	maxHistoryLimit     = 100
+)
 
-func NewChatUseCase(cfg *config.Config, chatRepo chat.Repository, schoolRepo school.Repository, logger logger.Logger) chat.UseCase {
-	return &chatUseCase{cfg: cfg, chatRepo: chatRepo, schoolRepo: schoolRepo, logger: logger}
+type chatUseCase struct {
+	chatRepo            chat.Repository
+	schoolRepo          school.Repository
+	classRepo           class.Repository
+	courseRepo          course.Repository
+	projectRepo         project.Repository
+	userRepo            user.Repository
+	notificationUseCase notification.UseCase
+	hub                 realtime.Hub
+	storage             storage.Storage
+	previewer           preview.Generator
+	cfg                 *config.Config
+	logger              logger.Logger
 }
 
-func (u *chatUseCase) SaveMessage(msg *models.Message) (*models.Message, error) {
-	return u.chatRepo.SaveMessage(msg)
+func NewChatUseCase(cfg *config.Config, chatRepo chat.Repository, schoolRepo school.Repository, classRepo class.Repository, courseRepo course.Repository, projectRepo project.Repository, userRepo user.Repository, notificationUseCase notification.UseCase, hub realtime.Hub, storage storage.Storage, previewer preview.Generator, logger logger.Logger) chat.UseCase {
+	return &chatUseCase{
+		cfg:                 cfg,
+		chatRepo:            chatRepo,
+		schoolRepo:          schoolRepo,
+		classRepo:           classRepo,
+		courseRepo:          courseRepo,
+		projectRepo:         projectRepo,
+		userRepo:            userRepo,
+		notificationUseCase: notificationUseCase,
+		hub:                 hub,
+		storage:             storage,
+		previewer:           previewer,
+		logger:              logger,
+	}
 }
 
 func (u *chatUseCase) Create(channel *models.Channel) (*models.Channel, error) {
+	blocked, err := u.chatRepo.IsBlocked(*channel.FirstUserId, *channel.SecondUserId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if blocked {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "One of the users blocked the other",
+		}
+	}
+
+	channel.Kind = models.CHANNEL_DIRECT
+	channel.Members = []models.ChannelMember{
+		{UserId: *channel.FirstUserId},
+		{UserId: *channel.SecondUserId},
+	}
+
 	return u.chatRepo.Create(channel)
 }
 
 func (u *chatUseCase) GetAllByUser(userId uint) (*[]models.Channel, error) {
-	return u.chatRepo.GetAllByUser(userId)
+	channels, err := u.chatRepo.GetAllByUser(userId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.fillSummaries(userId, *channels); err != nil {
+		return nil, err
+	}
+
+	return channels, nil
+}
+
+func (u *chatUseCase) GetById(user *models.User, id uint) (*models.Channel, error) {
+	if err := u.checkMember(id, user.ID); err != nil {
+		return nil, err
+	}
+
+	channel, err := u.chatRepo.GetById(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	channels := []models.Channel{*channel}
+
+	if err := u.fillSummaries(user.ID, channels); err != nil {
+		return nil, err
+	}
+
+	return &channels[0], nil
+}
+
+func (u *chatUseCase) checkMember(channelId uint, userId uint) error {
+	isMember, err := u.chatRepo.IsMember(channelId, userId)
+
+	if err != nil {
+		return err
+	}
+
+	if !isMember {
+		return chat.ErrNotMember
+	}
+
+	return nil
+}
+
+// Set the last message and the unread count of the channels for the user
+func (u *chatUseCase) fillSummaries(userId uint, channels []models.Channel) error {
+	var channelIds []uint
+	for _, channel := range channels {
+		channelIds = append(channelIds, channel.ID)
+	}
+
+	lastMessages, err := u.chatRepo.GetLastMessages(channelIds)
+
+	if err != nil {
+		return err
+	}
+
+	unreads, err := u.chatRepo.GetUnreadCounts(userId, channelIds)
+
+	if err != nil {
+		return err
+	}
+
+	for i := range channels {
+		channel := &channels[i]
+
+		for _, message := range *lastMessages {
+			if message.ChannelId == channel.ID {
+				channel.LastMessage = &message
+			}
+		}
+
+		for _, unread := range *unreads {
+			if unread.ChannelId == channel.ID {
+				channel.UnreadCount = unread.Count
+			}
+		}
+	}
+
+	return nil
+}
+
+func (u *chatUseCase) GetMessages(user *models.User, channelId uint, before *uint, limit int) (*models.MessagePage, error) {
+	if err := u.checkMember(channelId, user.ID); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	limit = min(limit, maxHistoryLimit)
+
+	// One more message to know if there is a previous page
+	messages, err := u.chatRepo.GetMessages(channelId, before, limit+1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.MessagePage{Messages: *messages}
+
+	if len(page.Messages) > limit {
+		page.Messages = page.Messages[:limit]
+		cursor := page.Messages[limit-1].ID
+		page.NextCursor = &cursor
+	}
+
+	slices.Reverse(page.Messages)
+
+	// Files of deleted messages are not shown anymore
+	for i := range page.Messages {
+		if page.Messages[i].RemovedAt != nil {
+			page.Messages[i].Attachments = []models.MessageAttachment{}
+		}
+	}
+
+	return page, nil
 }
 
-func (u *chatUseCase) GetById(id uint) (*models.Channel, error) {
-	return u.chatRepo.GetById(id)
+func (u *chatUseCase) SearchMessages(user *models.User, query string, before *uint, limit int) (*models.MessageSearchPage, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The search query is empty",
+		}
+	}
+
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	limit = min(limit, maxHistoryLimit)
+
+	var beforeId uint
+	if before != nil {
+		beforeId = *before
+	}
+
+	// One more result to know if there is a next page
+	results, err := u.chatRepo.SearchMessages(user.ID, query, beforeId, limit+1)
+
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.MessageSearchPage{Results: *results}
+
+	if len(page.Results) > limit {
+		page.Results = page.Results[:limit]
+		cursor := page.Results[limit-1].ID
+		page.NextCursor = &cursor
+	}
+
+	// Snippets come from user messages, only the highlight tags are kept as HTML
+	for i := range page.Results {
+		result := &page.Results[i]
+		result.Cursor = result.ID + 1
+		result.Snippet = html.EscapeString(result.Snippet)
+		result.Snippet = strings.ReplaceAll(result.Snippet, models.DocumentHighlightStart, "<mark>")
+		result.Snippet = strings.ReplaceAll(result.Snippet, models.DocumentHighlightStop, "</mark>")
+	}
+
+	return page, nil
+}
+
+func (u *chatUseCase) IsMember(channelId uint, userId uint) (bool, error) {
+	return u.chatRepo.IsMember(channelId, userId)
+}
+
+func isDirectWith(channel *models.Channel, userId uint) bool {
+	if channel.Kind != models.CHANNEL_DIRECT || channel.FirstUserId == nil || channel.SecondUserId == nil {
+		return false
+	}
+
+	return *channel.FirstUserId == userId || *channel.SecondUserId == userId
 }
 
 func (u *chatUseCase) GetAllPossibleChatStudent(user *models.User) (*[]models.User, error) {
@@ -41,7 +268,7 @@ func (u *chatUseCase) GetAllPossibleChatStudent(user *models.User) (*[]models.Us
 		return nil, err
 	}
 
-	existingChannels, err := u.GetAllByUser(user.ID)
+	existingChannels, err := u.chatRepo.GetAllByUser(user.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +279,7 @@ func (u *chatUseCase) GetAllPossibleChatStudent(user *models.User) (*[]models.Us
 		existingChannel := false
 
 		for _, channel := range *existingChannels {
-			if channel.FirstUserId == student.ID || channel.SecondUserId == student.ID {
+			if isDirectWith(&channel, student.ID) {
 				existingChannel = true
 				break
 			}
@@ -72,7 +299,7 @@ func (u *chatUseCase) GetAllPossibleChatTeacher(user *models.User) (*[]models.Us
 		return nil, err
 	}
 
-	existingChannels, err := u.GetAllByUser(user.ID)
+	existingChannels, err := u.chatRepo.GetAllByUser(user.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -83,7 +310,7 @@ func (u *chatUseCase) GetAllPossibleChatTeacher(user *models.User) (*[]models.Us
 		existingChannel := false
 
 		for _, channel := range *existingChannels {
-			if channel.FirstUserId == teacher.ID || channel.SecondUserId == teacher.ID {
+			if isDirectWith(&channel, teacher.ID) {
 				existingChannel = true
 				break
 			}
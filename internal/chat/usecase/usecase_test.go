@@ -0,0 +1,167 @@
+package usecase
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/esgi-challenge/backend/internal/chat/mock"
+	"github.com/esgi-challenge/backend/internal/models"
+	schoolMock "github.com/esgi-challenge/backend/internal/school/mock"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/esgi-challenge/backend/pkg/realtime"
+	"github.com/esgi-challenge/backend/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func messagesWithIds(channelId uint, ids ...uint) *[]models.Message {
+	var messages []models.Message
+	for _, id := range ids {
+		messages = append(messages, models.Message{GormModel: models.GormModel{ID: id}, ChannelId: channelId})
+	}
+
+	return &messages
+}
+
+func TestGetMessages(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewChatUseCase(nil, mockChatRepo, mockSchoolRepo, nil, nil, nil, nil, nil, realtime.NewLocalHub(), storage.Storage{}, nil, logger)
+
+	user := &models.User{GormModel: models.GormModel{ID: 1}}
+
+	t.Run("page with a previous page", func(t *testing.T) {
+		mockChatRepo.EXPECT().IsMember(uint(5), user.ID).Return(true, nil)
+		mockChatRepo.EXPECT().GetMessages(uint(5), nil, 3).Return(messagesWithIds(5, 30, 29, 28), nil)
+
+		page, err := useCase.GetMessages(user, 5, nil, 2)
+		assert.NoError(t, err)
+		assert.Len(t, page.Messages, 2)
+		assert.Equal(t, uint(29), page.Messages[0].ID)
+		assert.Equal(t, uint(30), page.Messages[1].ID)
+		if assert.NotNil(t, page.NextCursor) {
+			assert.Equal(t, uint(29), *page.NextCursor)
+		}
+	})
+
+	t.Run("first message reached", func(t *testing.T) {
+		before := uint(29)
+
+		mockChatRepo.EXPECT().IsMember(uint(5), user.ID).Return(true, nil)
+		mockChatRepo.EXPECT().GetMessages(uint(5), &before, 3).Return(messagesWithIds(5, 28, 27), nil)
+
+		page, err := useCase.GetMessages(user, 5, &before, 2)
+		assert.NoError(t, err)
+		assert.Len(t, page.Messages, 2)
+		assert.Equal(t, uint(27), page.Messages[0].ID)
+		assert.Nil(t, page.NextCursor)
+	})
+
+	t.Run("default and maximum limit", func(t *testing.T) {
+		mockChatRepo.EXPECT().IsMember(uint(5), user.ID).Return(true, nil).Times(2)
+		mockChatRepo.EXPECT().GetMessages(uint(5), nil, defaultHistoryLimit+1).Return(&[]models.Message{}, nil)
+		mockChatRepo.EXPECT().GetMessages(uint(5), nil, maxHistoryLimit+1).Return(&[]models.Message{}, nil)
+
+		_, err := useCase.GetMessages(user, 5, nil, 0)
+		assert.NoError(t, err)
+
+		_, err = useCase.GetMessages(user, 5, nil, 1000)
+		assert.NoError(t, err)
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		mockChatRepo.EXPECT().IsMember(uint(6), user.ID).Return(false, nil)
+
+		page, err := useCase.GetMessages(user, 6, nil, 2)
+		assert.Nil(t, page)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "You are not a member of this channel",
+		}, err)
+	})
+}
+
+func TestGetAllChannelsByUser(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewChatUseCase(nil, mockChatRepo, mockSchoolRepo, nil, nil, nil, nil, nil, realtime.NewLocalHub(), storage.Storage{}, nil, logger)
+
+	t.Run("last message and unread count", func(t *testing.T) {
+		channels := &[]models.Channel{
+			{GormModel: models.GormModel{ID: 1}},
+			{GormModel: models.GormModel{ID: 2}},
+		}
+
+		mockChatRepo.EXPECT().GetAllByUser(uint(4)).Return(channels, nil)
+		mockChatRepo.EXPECT().GetLastMessages([]uint{1, 2}).Return(messagesWithIds(2, 12), nil)
+		mockChatRepo.EXPECT().GetUnreadCounts(uint(4), []uint{1, 2}).Return(&[]models.ChannelUnread{{ChannelId: 1, Count: 3}}, nil)
+
+		summaries, err := useCase.GetAllByUser(4)
+		assert.NoError(t, err)
+
+		assert.Nil(t, (*summaries)[0].LastMessage)
+		assert.Equal(t, int64(3), (*summaries)[0].UnreadCount)
+
+		if assert.NotNil(t, (*summaries)[1].LastMessage) {
+			assert.Equal(t, uint(12), (*summaries)[1].LastMessage.ID)
+		}
+		assert.Equal(t, int64(0), (*summaries)[1].UnreadCount)
+	})
+}
+
+func TestSearchMessages(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChatRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewChatUseCase(nil, mockChatRepo, mockSchoolRepo, nil, nil, nil, nil, nil, realtime.NewLocalHub(), storage.Storage{}, nil, logger)
+
+	user := &models.User{GormModel: models.GormModel{ID: 4}}
+
+	t.Run("channels of the user", func(t *testing.T) {
+		results := &[]models.MessageSearchResult{
+			{Message: models.Message{GormModel: models.GormModel{ID: 12}, ChannelId: 5}, Snippet: "<b>see</b> you " + models.DocumentHighlightStart + "tomorrow" + models.DocumentHighlightStop},
+			{Message: models.Message{GormModel: models.GormModel{ID: 8}, ChannelId: 6}},
+		}
+
+		mockChatRepo.EXPECT().SearchMessages(user.ID, "tomorrow", uint(0), 2).Return(results, nil)
+
+		page, err := useCase.SearchMessages(user, "tomorrow", nil, 1)
+		assert.NoError(t, err)
+		assert.Len(t, page.Results, 1)
+		assert.Equal(t, "&lt;b&gt;see&lt;/b&gt; you <mark>tomorrow</mark>", page.Results[0].Snippet)
+		assert.Equal(t, uint(13), page.Results[0].Cursor)
+		if assert.NotNil(t, page.NextCursor) {
+			assert.Equal(t, uint(12), *page.NextCursor)
+		}
+	})
+
+	t.Run("empty query", func(t *testing.T) {
+		page, err := useCase.SearchMessages(user, " ", nil, 10)
+		assert.Nil(t, page)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The search query is empty",
+		}, err)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/chat"
 	"github.com/esgi-challenge/backend/internal/class"
 	"github.com/esgi-challenge/backend/internal/models"
 	"github.com/esgi-challenge/backend/internal/path"
@@ -14,22 +15,31 @@ import (
 )
 
 type classUseCase struct {
-	classRepo  class.Repository
-	schoolRepo school.Repository
-	pathRepo   path.Repository
-	userRepo   user.Repository
-	cfg        *config.Config
-	logger     logger.Logger
+	classRepo   class.Repository
+	schoolRepo  school.Repository
+	pathRepo    path.Repository
+	userRepo    user.Repository
+	chatUseCase chat.UseCase
+	cfg         *config.Config
+	logger      logger.Logger
 }
 
-func NewClassUseCase(cfg *config.Config, classRepo class.Repository, pathRepo path.Repository, schoolRepo school.Repository, userRepo user.Repository, logger logger.Logger) class.UseCase {
+func NewClassUseCase(cfg *config.Config, classRepo class.Repository, pathRepo path.Repository, schoolRepo school.Repository, userRepo user.Repository, chatUseCase chat.UseCase, logger logger.Logger) class.UseCase {
 	return &classUseCase{
-		cfg:        cfg,
-		classRepo:  classRepo,
-		pathRepo:   pathRepo,
-		schoolRepo: schoolRepo,
-		userRepo:   userRepo,
-		logger:     logger,
+		cfg:         cfg,
+		classRepo:   classRepo,
+		pathRepo:    pathRepo,
+		schoolRepo:  schoolRepo,
+		userRepo:    userRepo,
+		chatUseCase: chatUseCase,
+		logger:      logger,
+	}
+}
+
+// The class and courses channels members follow the class students, a failed sync is fixed by the periodic one
+func (u *classUseCase) syncChannels(classId uint) {
+	if err := u.chatUseCase.SyncClassChannels(classId); err != nil {
+		u.logger.Errorf("Chat: class %d channels: %v", classId, err)
 	}
 }
 
@@ -53,7 +63,15 @@ func (u *classUseCase) Create(user *models.User, class *models.Class) (*models.C
 		}
 	}
 
-	return u.classRepo.Create(class)
+	class, err = u.classRepo.Create(class)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.syncChannels(class.ID)
+
+	return class, nil
 }
 
 func (u *classUseCase) GetAllBySchoolId(schoolId uint) (*[]models.Class, error) {
@@ -83,7 +101,15 @@ func (u *classUseCase) Add(id uint, addClass *models.ClassAdd) (*models.User, er
 
 	student.ClassRefer = &class.ID
 
-	return u.userRepo.Update(student.ID, student)
+	student, err = u.userRepo.Update(student.ID, student)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.syncChannels(class.ID)
+
+	return student, nil
 }
 
 func (u *classUseCase) Remove(id uint, removeClass *models.ClassRemove) (*models.User, error) {
@@ -101,7 +127,15 @@ func (u *classUseCase) Remove(id uint, removeClass *models.ClassRemove) (*models
 
 	student.ClassRefer = nil
 
-	return u.userRepo.Update(student.ID, student)
+	student, err = u.userRepo.Update(student.ID, student)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.syncChannels(id)
+
+	return student, nil
 }
 
 func (u *classUseCase) GetClassLessStudents(schoolId uint) (*[]models.User, error) {
@@ -122,7 +156,22 @@ func (u *classUseCase) Update(id uint, updatedClass *models.Class) (*models.Clas
 	///////////////////////////////////////
 
 	updatedClass.ID = id
-	return u.classRepo.Update(id, updatedClass)
+	updatedClass, err = u.classRepo.Update(id, updatedClass)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.syncChannels(id)
+
+	// The students left the courses of the previous path
+	if dbClass.PathId != updatedClass.PathId {
+		if err := u.chatUseCase.SyncPathChannels(dbClass.PathId); err != nil {
+			u.logger.Errorf("Chat: path %d channels: %v", dbClass.PathId, err)
+		}
+	}
+
+	return updatedClass, nil
 }
 
 func (u *classUseCase) Delete(user *models.User, id uint) error {
@@ -147,5 +196,17 @@ func (u *classUseCase) Delete(user *models.User, id uint) error {
 		}
 	}
 
-	return u.classRepo.Delete(id)
+	if err := u.classRepo.Delete(id); err != nil {
+		return err
+	}
+
+	if err := u.chatUseCase.DeleteChannels(models.CHANNEL_CLASS, id); err != nil {
+		u.logger.Errorf("Chat: class %d channels: %v", id, err)
+	}
+
+	if err := u.chatUseCase.SyncPathChannels(class.PathId); err != nil {
+		u.logger.Errorf("Chat: path %d channels: %v", class.PathId, err)
+	}
+
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/chat"
 	"github.com/esgi-challenge/backend/internal/course"
 	"github.com/esgi-challenge/backend/internal/models"
 	"github.com/esgi-challenge/backend/internal/path"
@@ -13,20 +14,29 @@ import (
 )
 
 type courseUseCase struct {
-	courseRepo course.Repository
-	pathRepo   path.Repository
-	schoolRepo school.Repository
-	cfg        *config.Config
-	logger     logger.Logger
+	courseRepo  course.Repository
+	pathRepo    path.Repository
+	schoolRepo  school.Repository
+	chatUseCase chat.UseCase
+	cfg         *config.Config
+	logger      logger.Logger
 }
 
-func NewCourseUseCase(cfg *config.Config, courseRepo course.Repository, pathRepo path.Repository, schoolRepo school.Repository, logger logger.Logger) course.UseCase {
+func NewCourseUseCase(cfg *config.Config, courseRepo course.Repository, pathRepo path.Repository, schoolRepo school.Repository, chatUseCase chat.UseCase, logger logger.Logger) course.UseCase {
 	return &courseUseCase{
-		cfg:        cfg,
-		courseRepo: courseRepo,
-		pathRepo:   pathRepo,
-		schoolRepo: schoolRepo,
-		logger:     logger,
+		cfg:         cfg,
+		courseRepo:  courseRepo,
+		pathRepo:    pathRepo,
+		schoolRepo:  schoolRepo,
+		chatUseCase: chatUseCase,
+		logger:      logger,
+	}
+}
+
+// A failed sync is fixed by the periodic one
+func (u *courseUseCase) syncChannel(courseId uint) {
+	if err := u.chatUseCase.SyncCourseChannel(courseId); err != nil {
+		u.logger.Errorf("Chat: course %d channel: %v", courseId, err)
 	}
 }
 
@@ -50,7 +60,15 @@ func (u *courseUseCase) Create(user *models.User, course *models.Course) (*model
 		}
 	}
 
-	return u.courseRepo.Create(course)
+	course, err = u.courseRepo.Create(course)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.syncChannel(course.ID)
+
+	return course, nil
 }
 
 func (u *courseUseCase) GetAll() (*[]models.Course, error) {
@@ -77,9 +95,25 @@ func (u *courseUseCase) Update(id uint, updatedCourse *models.Course) (*models.C
 	///////////////////////////////////////
 
 	updatedCourse.ID = id
-	return u.courseRepo.Update(id, updatedCourse)
+	updatedCourse, err = u.courseRepo.Update(id, updatedCourse)
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.syncChannel(id)
+
+	return updatedCourse, nil
 }
 
 func (u *courseUseCase) Delete(id uint) error {
-	return u.courseRepo.Delete(id)
+	if err := u.courseRepo.Delete(id); err != nil {
+		return err
+	}
+
+	if err := u.chatUseCase.DeleteChannels(models.CHANNEL_COURSE, id); err != nil {
+		u.logger.Errorf("Chat: course %d channel: %v", id, err)
+	}
+
+	return nil
 }
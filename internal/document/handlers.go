@@ -9,5 +9,11 @@ type Handlers interface {
 	GetById() gin.HandlerFunc
 	GetAllByUserId() gin.HandlerFunc
 	GetAll() gin.HandlerFunc
+	Search() gin.HandlerFunc
+	Download() gin.HandlerFunc
+	Reconcile() gin.HandlerFunc
 	Delete() gin.HandlerFunc
+	CreateUpload() gin.HandlerFunc
+	GetUpload() gin.HandlerFunc
+	PatchUpload() gin.HandlerFunc
 }
@@ -2,6 +2,7 @@ package http
 
 import (
 	"bufio"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
@@ -101,6 +102,210 @@ func (u *documentHandlers) Create() gin.HandlerFunc {
 	}
 }
 
+// Create Upload
+//
+//	@Summary		Start a resumable upload
+//	@Description	tus creation request, the file name and the optional course id are given base64 encoded in Upload-Metadata ("name <b64>,courseId <b64>")
+//	@Tags			Document
+//	@Produce		json
+//	@Param			Upload-Length	header		int		true	"Size of the file in bytes"
+//	@Param			Upload-Metadata	header		string	false	"tus metadata"
+//	@Success		201				{object}	models.DocumentUpload
+//	@Failure		400				{object}	errorHandler.HttpErr
+//	@Failure		413				{object}	errorHandler.HttpErr
+//	@Failure		500				{object}	errorHandler.HttpErr
+//	@Router			/documents/uploads [post]
+func (u *documentHandlers) CreateUpload() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		setTusHeaders(ctx)
+
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		length, err := strconv.ParseInt(ctx.GetHeader("Upload-Length"), 10, 64)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		metadata, err := parseUploadMetadata(ctx.GetHeader("Upload-Metadata"))
+
+		if err != nil || metadata["name"] == "" {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			return
+		}
+
+		upload := &models.DocumentUploadCreate{
+			Name:   metadata["name"],
+			Length: length,
+		}
+
+		if courseIdStr := metadata["courseId"]; courseIdStr != "" {
+			courseId, err := strconv.ParseUint(courseIdStr, 10, 32)
+
+			if err != nil {
+				ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+				u.logger.Infof("Request: %v", err.Error())
+				return
+			}
+
+			tmp := uint(courseId)
+			upload.CourseId = &tmp
+		}
+
+		uploadDb, err := u.documentUseCase.CreateUpload(user, upload)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.Header("Location", fmt.Sprintf("%s/%d", ctx.Request.URL.Path, uploadDb.ID))
+		ctx.JSON(http.StatusCreated, uploadDb)
+	}
+}
+
+// Get Upload
+//
+//	@Summary		Get a resumable upload offset
+//	@Description	tus HEAD request, the number of bytes already received is returned in the Upload-Offset header
+//	@Tags			Document
+//	@Param			id	path	int	true	"id"
+//	@Success		200
+//	@Failure		400
+//	@Failure		403
+//	@Failure		404
+//	@Router			/documents/uploads/{id} [head]
+func (u *documentHandlers) GetUpload() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		setTusHeaders(ctx)
+
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		upload, err := u.documentUseCase.GetUpload(user, uint(idInt))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.Header("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+		ctx.Header("Upload-Length", strconv.FormatInt(upload.Length, 10))
+		ctx.Status(http.StatusOK)
+	}
+}
+
+// Patch Upload
+//
+//	@Summary		Send a chunk of a resumable upload
+//	@Description	tus PATCH request, the body is appended at Upload-Offset. Answers 204 while the upload is incomplete and 201 with the created document after the last chunk
+//	@Tags			Document
+//	@Accept			application/offset+octet-stream
+//	@Produce		json
+//	@Param			id				path		int	true	"id"
+//	@Param			Upload-Offset	header		int	true	"Offset of the chunk"
+//	@Success		201				{object}	models.Document
+//	@Success		204
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		409	{object}	errorHandler.HttpErr
+//	@Failure		413	{object}	errorHandler.HttpErr
+//	@Failure		415	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/documents/uploads/{id} [patch]
+func (u *documentHandlers) PatchUpload() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		setTusHeaders(ctx)
+
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		if ctx.ContentType() != tusContentType {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(errorHandler.HttpError{
+				HttpStatus: http.StatusUnsupportedMediaType,
+				HttpError:  fmt.Sprintf("Content-Type must be %s", tusContentType),
+			}))
+			return
+		}
+
+		offset, err := strconv.ParseInt(ctx.GetHeader("Upload-Offset"), 10, 64)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		// One more byte than allowed to detect chunks that are too big
+		chunk, err := io.ReadAll(io.LimitReader(ctx.Request.Body, models.DocumentUploadMaxChunk+1))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		if len(chunk) > models.DocumentUploadMaxChunk {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(errorHandler.HttpError{
+				HttpStatus: http.StatusRequestEntityTooLarge,
+				HttpError:  "The chunk is too large",
+			}))
+			return
+		}
+
+		upload, document, err := u.documentUseCase.PatchUpload(user, uint(idInt), offset, chunk)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.Header("Upload-Offset", strconv.FormatInt(upload.Offset, 10))
+
+		if document != nil {
+			ctx.JSON(http.StatusCreated, document)
+			return
+		}
+
+		ctx.Status(http.StatusNoContent)
+	}
+}
+
 // Read All
 //
 //	@Summary		Get all documents
@@ -165,6 +370,79 @@ func (u *documentHandlers) GetAll() gin.HandlerFunc {
 	}
 }
 
+// Search
+//
+//	@Summary		Search documents
+//	@Description	Full text search on the name and content of the documents of the user school, matches are highlighted with <mark> tags in the snippets
+//	@Tags			Document
+//	@Produce		json
+//	@Param			q	query		string	true	"Search query"
+//	@Success		200	{object}	[]models.DocumentSearchResult
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/documents/search [get]
+func (u *documentHandlers) Search() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		results, err := u.documentUseCase.Search(user, ctx.Query("q"))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, results)
+	}
+}
+
+// Reconcile
+//
+//	@Summary		Reconcile documents storage
+//	@Description	Report the bucket files no document references and the documents whose file is missing, clean removes them
+//	@Tags			Document
+//	@Produce		json
+//	@Param			clean	query		bool	false	"Delete orphaned files and dangling documents"
+//	@Success		200		{object}	models.DocumentReconcileReport
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		401		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/documents/reconcile [post]
+func (u *documentHandlers) Reconcile() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.SUPERADMIN)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		clean, err := strconv.ParseBool(ctx.DefaultQuery("clean", "false"))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		report, err := u.documentUseCase.Reconcile(clean)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, report)
+	}
+}
+
 // Read
 //
 //	@Summary		Get document by id
@@ -207,6 +485,56 @@ func (u *documentHandlers) GetById() gin.HandlerFunc {
 	}
 }
 
+// Download
+//
+//	@Summary		Download document by id
+//	@Description	Download document by id, only once it has been scanned and is clean
+//	@Tags			Document
+//	@Produce		octet-stream
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{file}		file
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		403	{object}	errorHandler.HttpErr
+//	@Failure		404	{object}	errorHandler.HttpErr
+//	@Failure		409	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/documents/{id}/download [get]
+func (u *documentHandlers) Download() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		document, file, err := u.documentUseCase.Download(user, uint(idInt))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", document.Name))
+		contentType := document.ContentType
+		if contentType == "" {
+			contentType = http.DetectContentType(file)
+		}
+
+		ctx.Data(http.StatusOK, contentType, file)
+	}
+}
+
 // Delete
 //
 //	@Summary		Delete document by id
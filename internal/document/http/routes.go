@@ -9,6 +9,12 @@ func SetupDocumentRoutes(documentGroup *gin.RouterGroup, h document.Handlers) {
 	documentGroup.POST("", h.Create())
 	documentGroup.GET("", h.GetAllByUserId())
 	documentGroup.GET("/school", h.GetAll())
+	documentGroup.GET("/search", h.Search())
+	documentGroup.POST("/reconcile", h.Reconcile())
 	documentGroup.GET("/:id", h.GetById())
+	documentGroup.GET("/:id/download", h.Download())
 	documentGroup.DELETE("/:id", h.Delete())
+	documentGroup.POST("/uploads", h.CreateUpload())
+	documentGroup.HEAD("/uploads/:id", h.GetUpload())
+	documentGroup.PATCH("/uploads/:id", h.PatchUpload())
 }
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Subset of the tus 1.0.0 protocol, with the creation extension
+const (
+	tusVersion     = "1.0.0"
+	tusContentType = "application/offset+octet-stream"
+)
+
+var errInvalidMetadata = errors.New("invalid Upload-Metadata header")
+
+func setTusHeaders(ctx *gin.Context) {
+	ctx.Header("Tus-Resumable", tusVersion)
+	ctx.Header("Cache-Control", "no-store")
+}
+
+// Upload-Metadata is a comma separated list of "key base64(value)" pairs, the value being optional
+func parseUploadMetadata(header string) (map[string]string, error) {
+	metadata := map[string]string{}
+
+	if strings.TrimSpace(header) == "" {
+		return metadata, nil
+	}
+
+	for _, pair := range strings.Split(header, ",") {
+		key, encoded, _ := strings.Cut(strings.TrimSpace(pair), " ")
+
+		if key == "" {
+			return nil, errInvalidMetadata
+		}
+
+		value, err := base64.StdEncoding.DecodeString(encoded)
+
+		if err != nil {
+			return nil, errInvalidMetadata
+		}
+
+		metadata[key] = string(value)
+	}
+
+	return metadata, nil
+}
@@ -11,6 +11,7 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	models "github.com/esgi-challenge/backend/internal/models"
 	gomock "go.uber.org/mock/gomock"
@@ -39,6 +40,22 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CompleteUpload mocks base method.
+func (m *MockRepository) CompleteUpload(id uint, document *models.Document) (*models.Document, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteUpload", id, document)
+	ret0, _ := ret[0].(*models.Document)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CompleteUpload indicates an expected call of CompleteUpload.
+func (mr *MockRepositoryMockRecorder) CompleteUpload(id, document any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*MockRepository)(nil).CompleteUpload), id, document)
+}
+
 // Create mocks base method.
 func (m *MockRepository) Create(document *models.Document) (*models.Document, error) {
 	m.ctrl.T.Helper()
@@ -54,6 +71,21 @@ func (mr *MockRepositoryMockRecorder) Create(document any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), document)
 }
 
+// CreateUpload mocks base method.
+func (m *MockRepository) CreateUpload(upload *models.DocumentUpload) (*models.DocumentUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUpload", upload)
+	ret0, _ := ret[0].(*models.DocumentUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUpload indicates an expected call of CreateUpload.
+func (mr *MockRepositoryMockRecorder) CreateUpload(upload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUpload", reflect.TypeOf((*MockRepository)(nil).CreateUpload), upload)
+}
+
 // Delete mocks base method.
 func (m *MockRepository) Delete(id uint) error {
 	m.ctrl.T.Helper()
@@ -68,6 +100,35 @@ func (mr *MockRepositoryMockRecorder) Delete(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), id)
 }
 
+// DeleteUpload mocks base method.
+func (m *MockRepository) DeleteUpload(id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUpload", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUpload indicates an expected call of DeleteUpload.
+func (mr *MockRepositoryMockRecorder) DeleteUpload(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpload", reflect.TypeOf((*MockRepository)(nil).DeleteUpload), id)
+}
+
+// GetAllByScanStatus mocks base method.
+func (m *MockRepository) GetAllByScanStatus(status models.DocumentScanStatus) (*[]models.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByScanStatus", status)
+	ret0, _ := ret[0].(*[]models.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByScanStatus indicates an expected call of GetAllByScanStatus.
+func (mr *MockRepositoryMockRecorder) GetAllByScanStatus(status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByScanStatus", reflect.TypeOf((*MockRepository)(nil).GetAllByScanStatus), status)
+}
+
 // GetAllBySchoolId mocks base method.
 func (m *MockRepository) GetAllBySchoolId(schoolId uint) (*[]models.Document, error) {
 	m.ctrl.T.Helper()
@@ -98,6 +159,51 @@ func (mr *MockRepositoryMockRecorder) GetAllByUserId(userId any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByUserId", reflect.TypeOf((*MockRepository)(nil).GetAllByUserId), userId)
 }
 
+// GetAllDeletedBefore mocks base method.
+func (m *MockRepository) GetAllDeletedBefore(before time.Time) (*[]models.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDeletedBefore", before)
+	ret0, _ := ret[0].(*[]models.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllDeletedBefore indicates an expected call of GetAllDeletedBefore.
+func (mr *MockRepositoryMockRecorder) GetAllDeletedBefore(before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeletedBefore", reflect.TypeOf((*MockRepository)(nil).GetAllDeletedBefore), before)
+}
+
+// GetAllUnscoped mocks base method.
+func (m *MockRepository) GetAllUnscoped() (*[]models.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUnscoped")
+	ret0, _ := ret[0].(*[]models.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUnscoped indicates an expected call of GetAllUnscoped.
+func (mr *MockRepositoryMockRecorder) GetAllUnscoped() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUnscoped", reflect.TypeOf((*MockRepository)(nil).GetAllUnscoped))
+}
+
+// GetAllUploadsBefore mocks base method.
+func (m *MockRepository) GetAllUploadsBefore(before time.Time) (*[]models.DocumentUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUploadsBefore", before)
+	ret0, _ := ret[0].(*[]models.DocumentUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUploadsBefore indicates an expected call of GetAllUploadsBefore.
+func (mr *MockRepositoryMockRecorder) GetAllUploadsBefore(before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUploadsBefore", reflect.TypeOf((*MockRepository)(nil).GetAllUploadsBefore), before)
+}
+
 // GetById mocks base method.
 func (m *MockRepository) GetById(id uint) (*models.Document, error) {
 	m.ctrl.T.Helper()
@@ -112,3 +218,77 @@ func (mr *MockRepositoryMockRecorder) GetById(id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockRepository)(nil).GetById), id)
 }
+
+// GetUploadById mocks base method.
+func (m *MockRepository) GetUploadById(id uint) (*models.DocumentUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUploadById", id)
+	ret0, _ := ret[0].(*models.DocumentUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadById indicates an expected call of GetUploadById.
+func (mr *MockRepositoryMockRecorder) GetUploadById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadById", reflect.TypeOf((*MockRepository)(nil).GetUploadById), id)
+}
+
+// MarkPurged mocks base method.
+func (m *MockRepository) MarkPurged(id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPurged", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPurged indicates an expected call of MarkPurged.
+func (mr *MockRepositoryMockRecorder) MarkPurged(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPurged", reflect.TypeOf((*MockRepository)(nil).MarkPurged), id)
+}
+
+// Search mocks base method.
+func (m *MockRepository) Search(schoolId uint, query string, limit int) (*[]models.DocumentSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", schoolId, query, limit)
+	ret0, _ := ret[0].(*[]models.DocumentSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockRepositoryMockRecorder) Search(schoolId, query, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockRepository)(nil).Search), schoolId, query, limit)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(id uint, document *models.Document) (*models.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", id, document)
+	ret0, _ := ret[0].(*models.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(id, document any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), id, document)
+}
+
+// UpdateUploadOffset mocks base method.
+func (m *MockRepository) UpdateUploadOffset(id uint, from, to int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUploadOffset", id, from, to)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUploadOffset indicates an expected call of UpdateUploadOffset.
+func (mr *MockRepositoryMockRecorder) UpdateUploadOffset(id, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUploadOffset", reflect.TypeOf((*MockRepository)(nil).UpdateUploadOffset), id, from, to)
+}
@@ -54,6 +54,21 @@ func (mr *MockUseCaseMockRecorder) Create(user, document any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUseCase)(nil).Create), user, document)
 }
 
+// CreateUpload mocks base method.
+func (m *MockUseCase) CreateUpload(user *models.User, upload *models.DocumentUploadCreate) (*models.DocumentUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUpload", user, upload)
+	ret0, _ := ret[0].(*models.DocumentUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUpload indicates an expected call of CreateUpload.
+func (mr *MockUseCaseMockRecorder) CreateUpload(user, upload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUpload", reflect.TypeOf((*MockUseCase)(nil).CreateUpload), user, upload)
+}
+
 // Delete mocks base method.
 func (m *MockUseCase) Delete(user *models.User, id uint) error {
 	m.ctrl.T.Helper()
@@ -68,6 +83,22 @@ func (mr *MockUseCaseMockRecorder) Delete(user, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUseCase)(nil).Delete), user, id)
 }
 
+// Download mocks base method.
+func (m *MockUseCase) Download(user *models.User, id uint) (*models.Document, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", user, id)
+	ret0, _ := ret[0].(*models.Document)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockUseCaseMockRecorder) Download(user, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockUseCase)(nil).Download), user, id)
+}
+
 // GetAll mocks base method.
 func (m *MockUseCase) GetAll(user *models.User) (*[]models.Document, error) {
 	m.ctrl.T.Helper()
@@ -112,3 +143,106 @@ func (mr *MockUseCaseMockRecorder) GetById(user, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockUseCase)(nil).GetById), user, id)
 }
+
+// GetUpload mocks base method.
+func (m *MockUseCase) GetUpload(user *models.User, id uint) (*models.DocumentUpload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpload", user, id)
+	ret0, _ := ret[0].(*models.DocumentUpload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpload indicates an expected call of GetUpload.
+func (mr *MockUseCaseMockRecorder) GetUpload(user, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpload", reflect.TypeOf((*MockUseCase)(nil).GetUpload), user, id)
+}
+
+// PatchUpload mocks base method.
+func (m *MockUseCase) PatchUpload(user *models.User, id uint, offset int64, chunk []byte) (*models.DocumentUpload, *models.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchUpload", user, id, offset, chunk)
+	ret0, _ := ret[0].(*models.DocumentUpload)
+	ret1, _ := ret[1].(*models.Document)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PatchUpload indicates an expected call of PatchUpload.
+func (mr *MockUseCaseMockRecorder) PatchUpload(user, id, offset, chunk any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchUpload", reflect.TypeOf((*MockUseCase)(nil).PatchUpload), user, id, offset, chunk)
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockUseCase) PurgeDeleted() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockUseCaseMockRecorder) PurgeDeleted() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockUseCase)(nil).PurgeDeleted))
+}
+
+// PurgeExpiredUploads mocks base method.
+func (m *MockUseCase) PurgeExpiredUploads() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpiredUploads")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeExpiredUploads indicates an expected call of PurgeExpiredUploads.
+func (mr *MockUseCaseMockRecorder) PurgeExpiredUploads() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpiredUploads", reflect.TypeOf((*MockUseCase)(nil).PurgeExpiredUploads))
+}
+
+// Reconcile mocks base method.
+func (m *MockUseCase) Reconcile(clean bool) (*models.DocumentReconcileReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", clean)
+	ret0, _ := ret[0].(*models.DocumentReconcileReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockUseCaseMockRecorder) Reconcile(clean any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockUseCase)(nil).Reconcile), clean)
+}
+
+// ScanPending mocks base method.
+func (m *MockUseCase) ScanPending() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScanPending")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScanPending indicates an expected call of ScanPending.
+func (mr *MockUseCaseMockRecorder) ScanPending() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanPending", reflect.TypeOf((*MockUseCase)(nil).ScanPending))
+}
+
+// Search mocks base method.
+func (m *MockUseCase) Search(user *models.User, query string) (*[]models.DocumentSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", user, query)
+	ret0, _ := ret[0].(*[]models.DocumentSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockUseCaseMockRecorder) Search(user, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUseCase)(nil).Search), user, query)
+}
@@ -1,6 +1,8 @@
 package document
 
 import (
+	"time"
+
 	"github.com/esgi-challenge/backend/internal/models"
 )
 
@@ -8,7 +10,21 @@ type Repository interface {
 	Create(document *models.Document) (*models.Document, error)
 	GetAllByUserId(userId uint) (*[]models.Document, error)
 	GetAllBySchoolId(schoolId uint) (*[]models.Document, error)
+	GetAllByScanStatus(status models.DocumentScanStatus) (*[]models.Document, error)
+	// Soft deleted documents included
+	GetAllUnscoped() (*[]models.Document, error)
+	GetAllDeletedBefore(before time.Time) (*[]models.Document, error)
+	Search(schoolId uint, query string, limit int) (*[]models.DocumentSearchResult, error)
 
 	GetById(id uint) (*models.Document, error)
+	Update(id uint, document *models.Document) (*models.Document, error)
+	MarkPurged(id uint) error
 	Delete(id uint) error
+	CreateUpload(upload *models.DocumentUpload) (*models.DocumentUpload, error)
+	GetUploadById(id uint) (*models.DocumentUpload, error)
+	// Returns false when the offset is not the expected one anymore
+	UpdateUploadOffset(id uint, from int64, to int64) (bool, error)
+	CompleteUpload(id uint, document *models.Document) (*models.Document, bool, error)
+	GetAllUploadsBefore(before time.Time) (*[]models.DocumentUpload, error)
+	DeleteUpload(id uint) error
 }
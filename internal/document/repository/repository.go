@@ -1,9 +1,13 @@
 package repository
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/esgi-challenge/backend/internal/document"
 	"github.com/esgi-challenge/backend/internal/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type documentRepo struct {
@@ -62,6 +66,64 @@ func (r *documentRepo) GetAllByUserId(userId uint) (*[]models.Document, error) {
 	return &documents, nil
 }
 
+func (r *documentRepo) GetAllByScanStatus(status models.DocumentScanStatus) (*[]models.Document, error) {
+	var documents []models.Document
+
+	if err := r.db.Where("scan_status = ?", status).Find(&documents).Error; err != nil {
+		return nil, err
+	}
+
+	return &documents, nil
+}
+
+func (r *documentRepo) GetAllUnscoped() (*[]models.Document, error) {
+	var documents []models.Document
+
+	if err := r.db.Unscoped().Select("id", "path", "preview_path", "deleted_at", "purged_at").Find(&documents).Error; err != nil {
+		return nil, err
+	}
+
+	return &documents, nil
+}
+
+func (r *documentRepo) GetAllDeletedBefore(before time.Time) (*[]models.Document, error) {
+	var documents []models.Document
+
+	if err := r.db.Unscoped().Where("deleted_at < ? AND purged_at IS NULL", before).Find(&documents).Error; err != nil {
+		return nil, err
+	}
+
+	return &documents, nil
+}
+
+func (r *documentRepo) MarkPurged(id uint) error {
+	if err := r.db.Unscoped().Model(&models.Document{}).Where("id = ?", id).Update("purged_at", time.Now()).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *documentRepo) Search(schoolId uint, query string, limit int) (*[]models.DocumentSearchResult, error) {
+	var results []models.DocumentSearchResult
+
+	headlineOptions := fmt.Sprintf("StartSel=%s, StopSel=%s, MaxFragments=2, MaxWords=20, MinWords=5", models.DocumentHighlightStart, models.DocumentHighlightStop)
+
+	if err := r.db.Raw(searchQuery, headlineOptions, query, schoolId, models.DOCUMENT_SCAN_CLEAN, limit).Scan(&results).Error; err != nil {
+		return nil, err
+	}
+
+	return &results, nil
+}
+
+func (r *documentRepo) Update(id uint, document *models.Document) (*models.Document, error) {
+	if err := r.db.Omit("Course").Save(document).Error; err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
 func (r *documentRepo) Delete(id uint) error {
 	if err := r.db.Debug().Delete(&models.Document{}, id).Error; err != nil {
 		return err
@@ -69,3 +131,84 @@ func (r *documentRepo) Delete(id uint) error {
 
 	return nil
 }
+
+func (r *documentRepo) CreateUpload(upload *models.DocumentUpload) (*models.DocumentUpload, error) {
+	if err := r.db.Create(upload).Error; err != nil {
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+func (r *documentRepo) GetUploadById(id uint) (*models.DocumentUpload, error) {
+	var upload models.DocumentUpload
+
+	if err := r.db.First(&upload, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &upload, nil
+}
+
+func (r *documentRepo) UpdateUploadOffset(id uint, from int64, to int64) (bool, error) {
+	result := r.db.Model(&models.DocumentUpload{}).Where("id = ? AND upload_offset = ?", id, from).Update("upload_offset", to)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// The upload row is locked while the document is saved, a retried or concurrent completion
+// gets the document of the first one and false
+func (r *documentRepo) CompleteUpload(id uint, document *models.Document) (*models.Document, bool, error) {
+	created := false
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var upload models.DocumentUpload
+
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&upload, id).Error; err != nil {
+			return err
+		}
+
+		if upload.DocumentId != nil {
+			return tx.Preload("Course").First(document, *upload.DocumentId).Error
+		}
+
+		if err := tx.Create(document).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&upload).Update("document_id", document.ID).Error; err != nil {
+			return err
+		}
+
+		created = true
+		return nil
+	})
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return document, created, nil
+}
+
+func (r *documentRepo) GetAllUploadsBefore(before time.Time) (*[]models.DocumentUpload, error) {
+	var uploads []models.DocumentUpload
+
+	if err := r.db.Where("created_at < ?", before).Find(&uploads).Error; err != nil {
+		return nil, err
+	}
+
+	return &uploads, nil
+}
+
+func (r *documentRepo) DeleteUpload(id uint) error {
+	if err := r.db.Delete(&models.DocumentUpload{}, id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
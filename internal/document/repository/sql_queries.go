@@ -0,0 +1,29 @@
+package repository
+
+import "github.com/esgi-challenge/backend/internal/models"
+
+const (
+	// Headlines are only computed on the best matches as ts_headline is costly on whole documents
+	searchQuery = `
+	SELECT
+		results.*,
+		ts_headline('simple', coalesce(nullif(results.content, ''), results.name), results.query, $1) AS snippet
+	FROM (
+		SELECT
+			documents.*,
+			query,
+			ts_rank(` + models.DocumentSearchVector + `, query) AS rank
+		FROM
+			"documents",
+			websearch_to_tsquery('simple', $2) AS query
+		WHERE
+			documents.deleted_at IS NULL
+			AND documents.school_id = $3
+			AND documents.scan_status = $4
+			AND (` + models.DocumentSearchVector + `) @@ query
+		ORDER BY rank DESC
+		LIMIT $5
+	) AS results
+	ORDER BY results.rank DESC
+	`
+)
@@ -9,5 +9,14 @@ type UseCase interface {
 	GetById(user *models.User, id uint) (*models.Document, error)
 	GetAllByUserId(userId uint) (*[]models.Document, error)
 	GetAll(user *models.User) (*[]models.Document, error)
+	Search(user *models.User, query string) (*[]models.DocumentSearchResult, error)
+	Download(user *models.User, id uint) (*models.Document, []byte, error)
+	ScanPending() error
+	PurgeDeleted() error
+	Reconcile(clean bool) (*models.DocumentReconcileReport, error)
 	Delete(user *models.User, id uint) error
+	CreateUpload(user *models.User, upload *models.DocumentUploadCreate) (*models.DocumentUpload, error)
+	GetUpload(user *models.User, id uint) (*models.DocumentUpload, error)
+	PatchUpload(user *models.User, id uint, offset int64, chunk []byte) (*models.DocumentUpload, *models.Document, error)
+	PurgeExpiredUploads() error
 }
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+)
+
+// Folders of the bucket holding documents files
+var storagePrefixes = []string{"files/", "previews/", "quarantine/"}
+
+// Objects younger than this may belong to an upload whose document isn't saved yet
+const orphanMinAge = time.Hour
+
+// Remove the files of documents deleted for longer than the grace period, the rows are kept for the foreign keys
+func (u *documentUseCase) PurgeDeleted() error {
+	documents, err := u.documentRepo.GetAllDeletedBefore(time.Now().Add(-u.cfg.DocumentGracePeriod))
+
+	if err != nil {
+		return err
+	}
+
+	for _, document := range *documents {
+		purged := true
+
+		for _, filename := range []string{document.Path, document.PreviewPath} {
+			if filename == "" {
+				continue
+			}
+
+			if err := u.storage.DeleteFile(context.Background(), filename); err != nil {
+				u.logger.Errorf("Purge: document %d: %v", document.ID, err)
+				purged = false
+			}
+		}
+
+		if !purged {
+			continue
+		}
+
+		if err := u.documentRepo.MarkPurged(document.ID); err != nil {
+			u.logger.Errorf("Purge: document %d: %v", document.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// Compare the bucket with the documents table, orphaned files are deleted and dangling documents soft deleted when clean is set
+func (u *documentUseCase) Reconcile(clean bool) (*models.DocumentReconcileReport, error) {
+	ctx := context.Background()
+
+	documents, err := u.documentRepo.GetAllUnscoped()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Files of purged documents are gone, they can't be referenced anymore
+	referenced := map[string]bool{}
+	for _, document := range *documents {
+		if document.PurgedAt == nil {
+			referenced[document.Path] = true
+			referenced[document.PreviewPath] = true
+		}
+	}
+
+	stored := map[string]bool{}
+	report := &models.DocumentReconcileReport{
+		OrphanedFiles:     []string{},
+		DanglingDocuments: []uint{},
+		Cleaned:           clean,
+	}
+
+	for _, prefix := range storagePrefixes {
+		files, err := u.storage.ListFiles(ctx, prefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range files {
+			stored[file.Name] = true
+
+			if !referenced[file.Name] && time.Since(file.Created) > orphanMinAge {
+				report.OrphanedFiles = append(report.OrphanedFiles, file.Name)
+			}
+		}
+	}
+
+	for _, document := range *documents {
+		if document.DeletedAt.Valid || stored[document.Path] {
+			continue
+		}
+
+		report.DanglingDocuments = append(report.DanglingDocuments, document.ID)
+	}
+
+	if clean {
+		for _, filename := range report.OrphanedFiles {
+			if err := u.storage.DeleteFile(ctx, filename); err != nil {
+				u.logger.Errorf("Reconcile: %s: %v", filename, err)
+			}
+		}
+
+		for _, id := range report.DanglingDocuments {
+			if err := u.documentRepo.Delete(id); err != nil {
+				u.logger.Errorf("Reconcile: document %d: %v", id, err)
+			}
+		}
+	}
+
+	u.logger.Infof("Reconcile: %d orphaned files, %d dangling documents, cleaned: %v", len(report.OrphanedFiles), len(report.DanglingDocuments), clean)
+
+	return report, nil
+}
@@ -0,0 +1,281 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/storage"
+)
+
+// Unfinished uploads older than this are removed with their chunks
+const uploadExpiration = 24 * time.Hour
+
+func uploadPrefix(id uint) string {
+	return fmt.Sprintf("uploads/%d/", id)
+}
+
+// Chunk names are zero padded offsets so that sorting them by name gives the file order
+func chunkName(id uint, offset int64) string {
+	return fmt.Sprintf("%s%020d", uploadPrefix(id), offset)
+}
+
+func (u *documentUseCase) CreateUpload(user *models.User, upload *models.DocumentUploadCreate) (*models.DocumentUpload, error) {
+	if upload.Length <= 0 {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The upload length must be positive",
+		}
+	}
+
+	if upload.Length > models.DocumentUploadMaxLength {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusRequestEntityTooLarge,
+			HttpError:  "The file is too large",
+		}
+	}
+
+	if upload.CourseId != nil {
+		if _, err := u.courseRepo.GetById(*upload.CourseId); err != nil {
+			return nil, err
+		}
+	}
+
+	return u.documentRepo.CreateUpload(&models.DocumentUpload{
+		Name:     upload.Name,
+		UserId:   user.ID,
+		CourseId: upload.CourseId,
+		Length:   upload.Length,
+	})
+}
+
+func (u *documentUseCase) GetUpload(user *models.User, id uint) (*models.DocumentUpload, error) {
+	upload, err := u.documentRepo.GetUploadById(id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if upload.UserId != user.ID {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This upload is not yours",
+		}
+	}
+
+	return upload, nil
+}
+
+// Append a chunk at the given offset, the document is returned once the last chunk is received
+func (u *documentUseCase) PatchUpload(user *models.User, id uint, offset int64, chunk []byte) (*models.DocumentUpload, *models.Document, error) {
+	upload, err := u.GetUpload(user, id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Retried last chunk, the document was already created
+	if upload.DocumentId != nil {
+		document, err := u.documentRepo.GetById(*upload.DocumentId)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return upload, document, nil
+	}
+
+	if offset != upload.Offset {
+		return nil, nil, errorHandler.HttpError{
+			HttpStatus: http.StatusConflict,
+			HttpError:  "The offset does not match the uploaded size",
+		}
+	}
+
+	if offset+int64(len(chunk)) > upload.Length {
+		return nil, nil, errorHandler.HttpError{
+			HttpStatus: http.StatusRequestEntityTooLarge,
+			HttpError:  "The chunk exceeds the upload length",
+		}
+	}
+
+	if len(chunk) > 0 {
+		err := u.storage.UploadChunk(context.Background(), chunkName(upload.ID, offset), chunk)
+
+		if errors.Is(err, storage.ErrChunkMismatch) {
+			return nil, nil, errorHandler.HttpError{
+				HttpStatus: http.StatusConflict,
+				HttpError:  "Another chunk was already uploaded at this offset",
+			}
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		updated, err := u.documentRepo.UpdateUploadOffset(upload.ID, offset, offset+int64(len(chunk)))
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !updated {
+			return nil, nil, errorHandler.HttpError{
+				HttpStatus: http.StatusConflict,
+				HttpError:  "The offset does not match the uploaded size",
+			}
+		}
+
+		upload.Offset += int64(len(chunk))
+	}
+
+	if upload.Offset < upload.Length {
+		return upload, nil, nil
+	}
+
+	document, err := u.completeUpload(user, upload)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return upload, document, nil
+}
+
+func (u *documentUseCase) completeUpload(user *models.User, upload *models.DocumentUpload) (*models.Document, error) {
+	ctx := context.Background()
+
+	chunks, err := u.storage.ListFiles(ctx, uploadPrefix(upload.ID))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(chunks) == 0 {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusConflict,
+			HttpError:  "The upload has no chunks",
+		}
+	}
+
+	sources := []string{}
+	for _, chunk := range chunks {
+		sources = append(sources, chunk.Name)
+	}
+	sort.Strings(sources)
+
+	filename, err := u.storage.ComposeFiles(ctx, sources)
+
+	if err != nil {
+		return nil, err
+	}
+
+	contentType, err := u.detectContentType(ctx, filename)
+
+	if err != nil {
+		u.deleteComposed(filename)
+		return nil, err
+	}
+
+	document, err := u.newDocument(user, upload.Name, upload.CourseId, filename, contentType)
+
+	if err != nil {
+		u.deleteComposed(filename)
+		return nil, err
+	}
+
+	document, created, err := u.documentRepo.CompleteUpload(upload.ID, document)
+
+	if err != nil {
+		u.deleteComposed(filename)
+		return nil, err
+	}
+
+	upload.DocumentId = &document.ID
+
+	// Completed meanwhile by a retried or concurrent request, its file is the one kept
+	if !created {
+		u.deleteComposed(filename)
+		return document, nil
+	}
+
+	// The file stays private and can't be downloaded until the scan is done
+	u.enqueueScan(*document)
+
+	// The chunks are kept until the document is saved so that a failed completion can be retried
+	if err := u.deleteChunks(upload.ID); err != nil {
+		u.logger.Errorf("Upload: upload %d: %v", upload.ID, err)
+	}
+
+	return document, nil
+}
+
+// Only the first bytes are read, they are all the detection looks at
+func (u *documentUseCase) detectContentType(ctx context.Context, filename string) (string, error) {
+	r, err := u.storage.OpenFile(ctx, filename)
+
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(r, head)
+
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	return http.DetectContentType(head[:n]), nil
+}
+
+// The chunks are composed again by the retry
+func (u *documentUseCase) deleteComposed(filename string) {
+	if err := u.storage.DeleteFile(context.Background(), filename); err != nil {
+		u.logger.Errorf("Upload: %s: %v", filename, err)
+	}
+}
+
+// Remove the chunks of abandoned uploads and the ones left by a failed cleanup of completed uploads
+func (u *documentUseCase) PurgeExpiredUploads() error {
+	uploads, err := u.documentRepo.GetAllUploadsBefore(time.Now().Add(-uploadExpiration))
+
+	if err != nil {
+		return err
+	}
+
+	for _, upload := range *uploads {
+		if err := u.deleteChunks(upload.ID); err != nil {
+			u.logger.Errorf("Upload: upload %d: %v", upload.ID, err)
+			continue
+		}
+
+		if err := u.documentRepo.DeleteUpload(upload.ID); err != nil {
+			u.logger.Errorf("Upload: upload %d: %v", upload.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (u *documentUseCase) deleteChunks(id uint) error {
+	chunks, err := u.storage.ListFiles(context.Background(), uploadPrefix(id))
+
+	if err != nil {
+		return err
+	}
+
+	for _, chunk := range chunks {
+		if err := u.storage.DeleteFile(context.Background(), chunk.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
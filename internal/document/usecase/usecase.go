@@ -2,7 +2,10 @@ package usecase
 
 import (
 	"context"
+	"html"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/esgi-challenge/backend/config"
 	"github.com/esgi-challenge/backend/internal/course"
@@ -10,28 +13,55 @@ import (
 	"github.com/esgi-challenge/backend/internal/models"
 	"github.com/esgi-challenge/backend/internal/school"
 	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/extract"
 	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/esgi-challenge/backend/pkg/preview"
+	"github.com/esgi-challenge/backend/pkg/scanner"
 	"github.com/esgi-challenge/backend/pkg/storage"
 )
 
+const scanTimeout = 2 * time.Minute
+
+const searchLimit = 50
+
 type documentUseCase struct {
 	documentRepo document.Repository
 	courseRepo   course.Repository
 	schoolRepo   school.Repository
 	cfg          *config.Config
 	storage      storage.Storage
+	scanner      scanner.Scanner
+	previewer    preview.Generator
+	extractor    extract.Extractor
+	scanQueue    chan models.Document
+	processQueue chan processJob
 	logger       logger.Logger
 }
 
-func NewDocumentUseCase(cfg *config.Config, documentRepo document.Repository, courseRepo course.Repository, schoolRepo school.Repository, logger logger.Logger, storage storage.Storage) document.UseCase {
-	return &documentUseCase{
+func NewDocumentUseCase(cfg *config.Config, documentRepo document.Repository, courseRepo course.Repository, schoolRepo school.Repository, logger logger.Logger, storage storage.Storage, scanner scanner.Scanner, previewer preview.Generator, extractor extract.Extractor) document.UseCase {
+	u := &documentUseCase{
 		cfg:          cfg,
 		documentRepo: documentRepo,
 		courseRepo:   courseRepo,
 		schoolRepo:   schoolRepo,
 		logger:       logger,
 		storage:      storage,
+		scanner:      scanner,
+		previewer:    previewer,
+		extractor:    extractor,
+		scanQueue:    make(chan models.Document, scanQueueSize),
+		processQueue: make(chan processJob, processQueueSize),
+	}
+
+	for i := 0; i < scanWorkers; i++ {
+		go u.scanWorker()
 	}
+
+	for i := 0; i < processWorkers; i++ {
+		go u.processWorker()
+	}
+
+	return u
 }
 
 func (u *documentUseCase) Create(user *models.User, document *models.DocumentCreate) (*models.Document, error) {
@@ -41,36 +71,123 @@ func (u *documentUseCase) Create(user *models.User, document *models.DocumentCre
 		return nil, err
 	}
 
+	return u.create(user, document.Name, document.CourseId, filename, http.DetectContentType(document.Byte))
+}
+
+// Save the document of a file already stored in the bucket and start its scan
+func (u *documentUseCase) create(user *models.User, name string, courseId *uint, filename string, contentType string) (*models.Document, error) {
+	dbDocument, err := u.newDocument(user, name, courseId, filename, contentType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dbDocument, err = u.documentRepo.Create(dbDocument)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The file stays private and can't be downloaded until the scan is done
+	u.enqueueScan(*dbDocument)
+
+	return dbDocument, nil
+}
+
+func (u *documentUseCase) newDocument(user *models.User, name string, courseId *uint, filename string, contentType string) (*models.Document, error) {
 	school, err := u.schoolRepo.GetByUser(user)
 
 	if err != nil {
 		return nil, err
 	}
 
-	var course *models.Course
+	dbDocument := &models.Document{
+		Name:        name,
+		Path:        filename,
+		UserId:      user.ID,
+		SchoolId:    school.ID,
+		ScanStatus:  models.DOCUMENT_SCAN_PENDING,
+		ContentType: contentType,
+	}
 
-	if document.CourseId != nil {
-		course, err = u.courseRepo.GetById(*document.CourseId)
+	if courseId != nil {
+		course, err := u.courseRepo.GetById(*courseId)
 
 		if err != nil {
 			return nil, err
 		}
 
-		return u.documentRepo.Create(&models.Document{
-			Name:     document.Name,
-			Path:     filename,
-			UserId:   user.ID,
-			Course:   *course,
-			SchoolId: school.ID,
-		})
-	}
-
-	return u.documentRepo.Create(&models.Document{
-		Name:     document.Name,
-		Path:     filename,
-		UserId:   user.ID,
-		SchoolId: school.ID,
-	})
+		dbDocument.Course = *course
+	}
+
+	return dbDocument, nil
+}
+
+// The file is streamed from the bucket to the scanner
+func (u *documentUseCase) scan(document models.Document) {
+	ctx, cancel := context.WithTimeout(context.Background(), scanTimeout)
+	defer cancel()
+
+	file, err := u.storage.OpenFile(ctx, document.Path)
+
+	if err != nil {
+		// Left pending, it will be scanned again by ScanPending
+		u.logger.Errorf("Scanner: document %d: %v", document.ID, err)
+		return
+	}
+
+	result, err := u.scanner.Scan(ctx, file)
+	file.Close()
+
+	if err != nil {
+		// Left pending, it will be scanned again by ScanPending
+		u.logger.Errorf("Scanner: document %d: %v", document.ID, err)
+		return
+	}
+
+	if result.Infected {
+		u.logger.Warnf("Scanner: document %d infected by %s, quarantining it", document.ID, result.Signature)
+
+		quarantined, err := u.storage.QuarantineFile(ctx, document.Path)
+		if err != nil {
+			u.logger.Errorf("Scanner: document %d: %v", document.ID, err)
+			return
+		}
+
+		document.Path = quarantined
+		document.ScanStatus = models.DOCUMENT_SCAN_INFECTED
+	} else {
+		if err := u.storage.PublishFile(ctx, document.Path); err != nil {
+			u.logger.Errorf("Scanner: document %d: %v", document.ID, err)
+			return
+		}
+
+		document.ScanStatus = models.DOCUMENT_SCAN_CLEAN
+	}
+
+	if _, err := u.documentRepo.Update(document.ID, &document); err != nil {
+		u.logger.Errorf("Scanner: document %d: %v", document.ID, err)
+		return
+	}
+
+	if document.ScanStatus == models.DOCUMENT_SCAN_CLEAN {
+		u.enqueueProcess(document)
+	}
+}
+
+func (u *documentUseCase) ScanPending() error {
+	documents, err := u.documentRepo.GetAllByScanStatus(models.DOCUMENT_SCAN_PENDING)
+
+	if err != nil {
+		return err
+	}
+
+	// Waits for room in the queue, the uploads keep being scanned meanwhile
+	for _, document := range *documents {
+		u.scanQueue <- document
+	}
+
+	return nil
 }
 
 func (u *documentUseCase) GetAll(user *models.User) (*[]models.Document, error) {
@@ -104,6 +221,81 @@ func (u *documentUseCase) GetById(user *models.User, id uint) (*models.Document,
 	return document, nil
 }
 
+func (u *documentUseCase) Search(user *models.User, query string) (*[]models.DocumentSearchResult, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The search query is empty",
+		}
+	}
+
+	school, err := u.schoolRepo.GetByUser(user)
+
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := u.documentRepo.Search(school.ID, query, searchLimit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Snippets come from user files, only the highlight tags are kept as HTML
+	for i := range *results {
+		result := &(*results)[i]
+		result.Snippet = html.EscapeString(result.Snippet)
+		result.Snippet = strings.ReplaceAll(result.Snippet, models.DocumentHighlightStart, "<mark>")
+		result.Snippet = strings.ReplaceAll(result.Snippet, models.DocumentHighlightStop, "</mark>")
+	}
+
+	return results, nil
+}
+
+func (u *documentUseCase) Download(user *models.User, id uint) (*models.Document, []byte, error) {
+	document, err := u.documentRepo.GetById(id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if document.UserId != user.ID {
+		school, err := u.schoolRepo.GetByUser(user)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if school.ID != document.SchoolId {
+			return nil, nil, errorHandler.HttpError{
+				HttpStatus: http.StatusForbidden,
+				HttpError:  "This document is not from your school",
+			}
+		}
+	}
+
+	switch document.ScanStatus {
+	case models.DOCUMENT_SCAN_PENDING:
+		return nil, nil, errorHandler.HttpError{
+			HttpStatus: http.StatusConflict,
+			HttpError:  "This document has not been scanned yet",
+		}
+	case models.DOCUMENT_SCAN_INFECTED:
+		return nil, nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This document is infected",
+		}
+	}
+
+	file, err := u.storage.ReadFile(context.Background(), document.Path)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return document, file, nil
+}
+
 func (u *documentUseCase) Delete(user *models.User, id uint) error {
 	// Check not needed but added to handle a not found error because gorm do not return
 	// error if delete on a row that does not exist
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/extract"
+	"github.com/esgi-challenge/backend/pkg/preview"
+)
+
+const (
+	scanWorkers      = 2
+	scanQueueSize    = 100
+	processWorkers   = 2
+	processQueueSize = 100
+	processTimeout   = time.Minute
+	// Files are read in memory to be processed, bigger ones get no preview nor content
+	processMaxSize = 50 * 1024 * 1024
+)
+
+// Scan the uploaded documents in the background, a few at a time to not overload the scanner
+func (u *documentUseCase) scanWorker() {
+	for document := range u.scanQueue {
+		u.scan(document)
+	}
+}
+
+// Never blocks the upload, the document is left pending when the queue is full and scanned by ScanPending
+func (u *documentUseCase) enqueueScan(document models.Document) {
+	select {
+	case u.scanQueue <- document:
+	default:
+		u.logger.Warnf("Scanner: queue full, document %d left pending", document.ID)
+	}
+}
+
+// The file is read from the bucket by the worker, not kept in memory while queued
+type processJob struct {
+	document models.Document
+}
+
+// Process clean documents in the background, rendering and parsing PDFs is too slow for the upload request
+func (u *documentUseCase) processWorker() {
+	for job := range u.processQueue {
+		u.process(job.document)
+	}
+}
+
+// Never blocks the scan, the document is left without preview nor content when the queue is full
+func (u *documentUseCase) enqueueProcess(document models.Document) {
+	select {
+	case u.processQueue <- processJob{document: document}:
+	default:
+		u.logger.Warnf("Process: queue full, document %d not processed", document.ID)
+	}
+}
+
+func (u *documentUseCase) readForProcess(ctx context.Context, filename string) ([]byte, error) {
+	r, err := u.storage.OpenFile(ctx, filename)
+
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(io.LimitReader(r, processMaxSize+1))
+}
+
+func (u *documentUseCase) process(document models.Document) {
+	ctx, cancel := context.WithTimeout(context.Background(), processTimeout)
+	defer cancel()
+
+	file, err := u.readForProcess(ctx, document.Path)
+
+	if err != nil {
+		u.logger.Errorf("Process: document %d: %v", document.ID, err)
+		return
+	}
+
+	if len(file) > processMaxSize {
+		u.logger.Infof("Process: document %d is too large to be processed", document.ID)
+		return
+	}
+
+	content, err := u.extractor.Extract(ctx, document.ContentType, file)
+
+	if err == nil {
+		document.Content = content
+	} else if !errors.Is(err, extract.ErrUnsupported) {
+		u.logger.Errorf("Extract: document %d: %v", document.ID, err)
+	}
+
+	thumbnail, err := u.previewer.Generate(ctx, document.ContentType, file)
+
+	if err == nil {
+		filename, err := u.storage.UploadPreview(ctx, thumbnail)
+
+		if err != nil {
+			u.logger.Errorf("Preview: document %d: %v", document.ID, err)
+		} else {
+			document.PreviewPath = filename
+			document.PreviewUrl = u.storage.PublicUrl(filename)
+		}
+	} else if !errors.Is(err, preview.ErrUnsupported) {
+		u.logger.Errorf("Preview: document %d: %v", document.ID, err)
+	}
+
+	if _, err := u.documentRepo.Update(document.ID, &document); err != nil {
+		u.logger.Errorf("Process: document %d: %v", document.ID, err)
+	}
+}
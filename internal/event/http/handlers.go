@@ -0,0 +1,280 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/event"
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/school"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/esgi-challenge/backend/pkg/request"
+	"github.com/gin-gonic/gin"
+)
+
+type eventHandlers struct {
+	cfg           *config.Config
+	eventUseCase  event.UseCase
+	schoolUseCase school.UseCase
+	logger        logger.Logger
+}
+
+func NewEventHandlers(cfg *config.Config, eventUseCase event.UseCase, schoolUseCase school.UseCase, logger logger.Logger) event.Handlers {
+	return &eventHandlers{cfg: cfg, eventUseCase: eventUseCase, schoolUseCase: schoolUseCase, logger: logger}
+}
+
+// Create
+//
+//	@Summary		Create new event
+//	@Description	Create an open day, a jury or a party on a campus, targeted at classes, paths or user kinds. Users going once the capacity is reached are waitlisted, no limit when 0. The audience is notified
+//	@Tags			Event
+//	@Accept			json
+//	@Produce		json
+//	@Param			event	body		models.EventCreate	true	"Event infos"
+//	@Success		201		{object}	models.Event
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/events [post]
+func (u *eventHandlers) Create() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.ADMINISTRATOR)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		school, err := u.schoolUseCase.GetByUser(user)
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		var body models.EventCreate
+
+		eventCreate, err := request.ValidateJSON(body, ctx)
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		event := &models.Event{
+			Title:       eventCreate.Title,
+			Description: eventCreate.Description,
+			SchoolId:    school.ID,
+			CampusId:    *eventCreate.CampusId,
+			StartAt:     time.Unix(int64(*eventCreate.StartAt), 0),
+			EndAt:       time.Unix(int64(*eventCreate.EndAt), 0),
+			Capacity:    eventCreate.Capacity,
+		}
+
+		for _, target := range eventCreate.Targets {
+			event.Targets = append(event.Targets, models.EventTarget{
+				Kind:     target.Kind,
+				TargetId: *target.TargetId,
+			})
+		}
+
+		eventDb, err := u.eventUseCase.Create(user, event)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, eventDb)
+	}
+}
+
+// Read
+//
+//	@Summary		Get all events
+//	@Description	Get the events of the school with their answers count, soonest first. Students and teachers only get the ones targeting them, with their own answer
+//	@Tags			Event
+//	@Produce		json
+//	@Param			from	query		int	false	"Unix timestamp, events ending after it, now by default"
+//	@Param			to		query		int	false	"Unix timestamp, events starting before it"
+//	@Success		200		{object}	[]models.Event
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/events [get]
+func (u *eventHandlers) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		period, err := request.ValidateEventPeriod(ctx)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		events, err := u.eventUseCase.GetAll(user, period)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, events)
+	}
+}
+
+// Read
+//
+//	@Summary		Get event by id
+//	@Description	Get event by id
+//	@Tags			Event
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{object}	models.Event
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		404	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/events/{id} [get]
+func (u *eventHandlers) GetById() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		event, err := u.eventUseCase.GetById(user, uint(idInt))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, event)
+	}
+}
+
+// Update
+//
+//	@Summary		Update event
+//	@Description	Update the event, its audience can't change. Waitlisted users get the places added by a bigger capacity
+//	@Tags			Event
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int					true	"id"
+//	@Param			event	body		models.EventUpdate	true	"Event infos"
+//	@Success		200		{object}	models.Event
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		403		{object}	errorHandler.HttpErr
+//	@Failure		404		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/events/{id} [put]
+func (u *eventHandlers) Update() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.ADMINISTRATOR)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		var body models.EventUpdate
+
+		eventUpdate, err := request.ValidateJSON(body, ctx)
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		event := &models.Event{
+			Title:       eventUpdate.Title,
+			Description: eventUpdate.Description,
+			CampusId:    *eventUpdate.CampusId,
+			StartAt:     time.Unix(int64(*eventUpdate.StartAt), 0),
+			EndAt:       time.Unix(int64(*eventUpdate.EndAt), 0),
+			Capacity:    eventUpdate.Capacity,
+		}
+
+		updatedEvent, err := u.eventUseCase.Update(user, uint(idInt), event)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, updatedEvent)
+	}
+}
+
+// Delete
+//
+//	@Summary		Delete event by id
+//	@Description	Delete event by id
+//	@Tags			Event
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{object}	nil
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		404	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/events/{id} [delete]
+func (u *eventHandlers) Delete() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.ADMINISTRATOR)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		err = u.eventUseCase.Delete(user, uint(idInt))
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, nil)
+	}
+}
@@ -0,0 +1,16 @@
+package http
+
+import (
+	"github.com/esgi-challenge/backend/internal/event"
+	"github.com/gin-gonic/gin"
+)
+
+func SetupEventRoutes(eventGroup *gin.RouterGroup, h event.Handlers) {
+	eventGroup.POST("", h.Create())
+	eventGroup.GET("", h.GetAll())
+	eventGroup.GET("/:id", h.GetById())
+	eventGroup.PUT("/:id", h.Update())
+	eventGroup.DELETE("/:id", h.Delete())
+	eventGroup.PUT("/:id/rsvp", h.Rsvp())
+	eventGroup.GET("/:id/attendees", h.GetAttendees())
+}
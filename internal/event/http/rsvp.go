@@ -0,0 +1,108 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/request"
+	"github.com/gin-gonic/gin"
+)
+
+// Rsvp
+//
+//	@Summary		Answer an event
+//	@Description	Answer going, notGoing or maybe to an event that is not over, for students and teachers. Users going once the event is full are waitlisted and get the first freed places, oldest answer first
+//	@Tags			Event
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		int						true	"id"
+//	@Param			rsvp	body		models.EventRsvpUpdate	true	"Answer"
+//	@Success		200		{object}	models.EventRsvp
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		403		{object}	errorHandler.HttpErr
+//	@Failure		404		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/events/{id}/rsvp [put]
+func (u *eventHandlers) Rsvp() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		var body models.EventRsvpUpdate
+
+		rsvpUpdate, err := request.ValidateJSON(body, ctx)
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		rsvp, err := u.eventUseCase.Rsvp(user, uint(idInt), rsvpUpdate.Status)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, rsvp)
+	}
+}
+
+// Read
+//
+//	@Summary		Get event attendees
+//	@Description	Get the answers of the audience by status, the waitlist in its order
+//	@Tags			Event
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{object}	models.EventAttendees
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		403	{object}	errorHandler.HttpErr
+//	@Failure		404	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/events/{id}/attendees [get]
+func (u *eventHandlers) GetAttendees() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.ADMINISTRATOR)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		attendees, err := u.eventUseCase.GetAttendees(user, uint(idInt))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, attendees)
+	}
+}
@@ -0,0 +1,149 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/event/mock"
+	"github.com/esgi-challenge/backend/internal/models"
+	schoolMock "github.com/esgi-challenge/backend/internal/school/mock"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/jwt"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRsvp(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewLogger()
+	logger.InitLogger()
+	mockUseCase := mock.NewMockUseCase(ctrl)
+	mockSchoolUseCase := schoolMock.NewMockUseCase(ctrl)
+	cfg := &config.Config{JwtSecret: "secret"}
+	handlers := NewEventHandlers(cfg, mockUseCase, mockSchoolUseCase, logger)
+
+	studentUser := &models.User{UserKind: models.NewUserKind(models.STUDENT)}
+	token, _ := jwt.Generate(cfg.JwtSecret, studentUser)
+
+	rsvpBody := func(status models.EventRsvpStatus) *bytes.Buffer {
+		body, err := json.Marshal(&models.EventRsvpUpdate{Status: status})
+		if err != nil {
+			t.Fatalf("Failed to marshal JSON: %v", err)
+		}
+
+		return bytes.NewBuffer(body)
+	}
+
+	t.Run("Rsvp request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/api/events/1/rsvp", rsvpBody(models.EVENT_RSVP_GOING))
+		req.Header.Set("Content-type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		mockUseCase.EXPECT().Rsvp(gomock.Any(), uint(1), models.EVENT_RSVP_GOING).Return(&models.EventRsvp{Status: models.EVENT_RSVP_GOING, Waitlisted: true}, nil)
+
+		handler := handlers.Rsvp()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Rsvp request unknown status", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/api/events/1/rsvp", rsvpBody("later"))
+		req.Header.Set("Content-type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler := handlers.Rsvp()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("Rsvp request event over", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPut, "/api/events/1/rsvp", rsvpBody(models.EVENT_RSVP_MAYBE))
+		req.Header.Set("Content-type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		mockUseCase.EXPECT().Rsvp(gomock.Any(), uint(1), models.EVENT_RSVP_MAYBE).Return(nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "This event is over",
+		})
+
+		handler := handlers.Rsvp()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func TestGetAllPeriod(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewLogger()
+	logger.InitLogger()
+	mockUseCase := mock.NewMockUseCase(ctrl)
+	mockSchoolUseCase := schoolMock.NewMockUseCase(ctrl)
+	cfg := &config.Config{JwtSecret: "secret"}
+	handlers := NewEventHandlers(cfg, mockUseCase, mockSchoolUseCase, logger)
+
+	studentUser := &models.User{UserKind: models.NewUserKind(models.STUDENT)}
+	token, _ := jwt.Generate(cfg.JwtSecret, studentUser)
+
+	t.Run("GetAll request with period", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/events?from=1000&to=2000", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+
+		mockUseCase.EXPECT().GetAll(gomock.Any(), gomock.Any()).DoAndReturn(func(user *models.User, period *models.EventPeriod) (*[]models.Event, error) {
+			assert.Equal(t, int64(1000), period.From.Unix())
+			assert.Equal(t, int64(2000), period.To.Unix())
+			return &[]models.Event{}, nil
+		})
+
+		handler := handlers.GetAll()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("GetAll request period ending before it starts", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/events?from=2000&to=1000", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+
+		handler := handlers.GetAll()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
@@ -0,0 +1,221 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/event/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/event/repository.go -destination=internal/event/mock/repository_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	models "github.com/esgi-challenge/backend/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountSchoolTargets mocks base method.
+func (m *MockRepository) CountSchoolTargets(schoolId uint, kind models.EventTargetKind, ids []uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSchoolTargets", schoolId, kind, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSchoolTargets indicates an expected call of CountSchoolTargets.
+func (mr *MockRepositoryMockRecorder) CountSchoolTargets(schoolId, kind, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSchoolTargets", reflect.TypeOf((*MockRepository)(nil).CountSchoolTargets), schoolId, kind, ids)
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(event *models.Event) (*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", event)
+	ret0, _ := ret[0].(*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), event)
+}
+
+// Delete mocks base method.
+func (m *MockRepository) Delete(id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoryMockRecorder) Delete(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), id)
+}
+
+// GetById mocks base method.
+func (m *MockRepository) GetById(id uint) (*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", id)
+	ret0, _ := ret[0].(*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockRepositoryMockRecorder) GetById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockRepository)(nil).GetById), id)
+}
+
+// GetBySchoolId mocks base method.
+func (m *MockRepository) GetBySchoolId(schoolId uint, period *models.EventPeriod) (*[]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySchoolId", schoolId, period)
+	ret0, _ := ret[0].(*[]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySchoolId indicates an expected call of GetBySchoolId.
+func (mr *MockRepositoryMockRecorder) GetBySchoolId(schoolId, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySchoolId", reflect.TypeOf((*MockRepository)(nil).GetBySchoolId), schoolId, period)
+}
+
+// GetRecipientIds mocks base method.
+func (m *MockRepository) GetRecipientIds(event *models.Event) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecipientIds", event)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecipientIds indicates an expected call of GetRecipientIds.
+func (mr *MockRepositoryMockRecorder) GetRecipientIds(event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipientIds", reflect.TypeOf((*MockRepository)(nil).GetRecipientIds), event)
+}
+
+// GetRsvpCounts mocks base method.
+func (m *MockRepository) GetRsvpCounts(eventIds []uint) ([]models.EventRsvpCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRsvpCounts", eventIds)
+	ret0, _ := ret[0].([]models.EventRsvpCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRsvpCounts indicates an expected call of GetRsvpCounts.
+func (mr *MockRepositoryMockRecorder) GetRsvpCounts(eventIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRsvpCounts", reflect.TypeOf((*MockRepository)(nil).GetRsvpCounts), eventIds)
+}
+
+// GetRsvps mocks base method.
+func (m *MockRepository) GetRsvps(eventId uint) ([]models.EventRsvp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRsvps", eventId)
+	ret0, _ := ret[0].([]models.EventRsvp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRsvps indicates an expected call of GetRsvps.
+func (mr *MockRepositoryMockRecorder) GetRsvps(eventId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRsvps", reflect.TypeOf((*MockRepository)(nil).GetRsvps), eventId)
+}
+
+// GetVisible mocks base method.
+func (m *MockRepository) GetVisible(schoolId, userId uint, period *models.EventPeriod) (*[]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVisible", schoolId, userId, period)
+	ret0, _ := ret[0].(*[]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVisible indicates an expected call of GetVisible.
+func (mr *MockRepositoryMockRecorder) GetVisible(schoolId, userId, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisible", reflect.TypeOf((*MockRepository)(nil).GetVisible), schoolId, userId, period)
+}
+
+// GetVisibleById mocks base method.
+func (m *MockRepository) GetVisibleById(schoolId, userId, id uint) (*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVisibleById", schoolId, userId, id)
+	ret0, _ := ret[0].(*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVisibleById indicates an expected call of GetVisibleById.
+func (mr *MockRepositoryMockRecorder) GetVisibleById(schoolId, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibleById", reflect.TypeOf((*MockRepository)(nil).GetVisibleById), schoolId, userId, id)
+}
+
+// SaveRsvp mocks base method.
+func (m *MockRepository) SaveRsvp(rsvp *models.EventRsvp) (*models.EventRsvp, []models.EventRsvp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRsvp", rsvp)
+	ret0, _ := ret[0].(*models.EventRsvp)
+	ret1, _ := ret[1].([]models.EventRsvp)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SaveRsvp indicates an expected call of SaveRsvp.
+func (mr *MockRepositoryMockRecorder) SaveRsvp(rsvp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRsvp", reflect.TypeOf((*MockRepository)(nil).SaveRsvp), rsvp)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(event *models.Event) (*models.Event, []models.EventRsvp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", event)
+	ret0, _ := ret[0].(*models.Event)
+	ret1, _ := ret[1].([]models.EventRsvp)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), event)
+}
@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/event/usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/event/usecase.go -destination=internal/event/mock/usecase_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	models "github.com/esgi-challenge/backend/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUseCase) Create(user *models.User, event *models.Event) (*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", user, event)
+	ret0, _ := ret[0].(*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUseCaseMockRecorder) Create(user, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUseCase)(nil).Create), user, event)
+}
+
+// Delete mocks base method.
+func (m *MockUseCase) Delete(user *models.User, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", user, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUseCaseMockRecorder) Delete(user, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUseCase)(nil).Delete), user, id)
+}
+
+// GetAll mocks base method.
+func (m *MockUseCase) GetAll(user *models.User, period *models.EventPeriod) (*[]models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", user, period)
+	ret0, _ := ret[0].(*[]models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockUseCaseMockRecorder) GetAll(user, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockUseCase)(nil).GetAll), user, period)
+}
+
+// GetAttendees mocks base method.
+func (m *MockUseCase) GetAttendees(user *models.User, id uint) (*models.EventAttendees, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendees", user, id)
+	ret0, _ := ret[0].(*models.EventAttendees)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendees indicates an expected call of GetAttendees.
+func (mr *MockUseCaseMockRecorder) GetAttendees(user, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendees", reflect.TypeOf((*MockUseCase)(nil).GetAttendees), user, id)
+}
+
+// GetById mocks base method.
+func (m *MockUseCase) GetById(user *models.User, id uint) (*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", user, id)
+	ret0, _ := ret[0].(*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockUseCaseMockRecorder) GetById(user, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockUseCase)(nil).GetById), user, id)
+}
+
+// Rsvp mocks base method.
+func (m *MockUseCase) Rsvp(user *models.User, id uint, status models.EventRsvpStatus) (*models.EventRsvp, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rsvp", user, id, status)
+	ret0, _ := ret[0].(*models.EventRsvp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rsvp indicates an expected call of Rsvp.
+func (mr *MockUseCaseMockRecorder) Rsvp(user, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rsvp", reflect.TypeOf((*MockUseCase)(nil).Rsvp), user, id, status)
+}
+
+// Update mocks base method.
+func (m *MockUseCase) Update(user *models.User, id uint, updatedEvent *models.Event) (*models.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", user, id, updatedEvent)
+	ret0, _ := ret[0].(*models.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUseCaseMockRecorder) Update(user, id, updatedEvent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUseCase)(nil).Update), user, id, updatedEvent)
+}
@@ -0,0 +1,25 @@
+package event
+
+import (
+	"github.com/esgi-challenge/backend/internal/models"
+)
+
+type Repository interface {
+	Create(event *models.Event) (*models.Event, error)
+	GetById(id uint) (*models.Event, error)
+	GetBySchoolId(schoolId uint, period *models.EventPeriod) (*[]models.Event, error)
+	// Events targeting the user, with the answer of the user
+	GetVisible(schoolId uint, userId uint, period *models.EventPeriod) (*[]models.Event, error)
+	GetVisibleById(schoolId uint, userId uint, id uint) (*models.Event, error)
+	// Classes or paths of the school among the ids
+	CountSchoolTargets(schoolId uint, kind models.EventTargetKind, ids []uint) (int64, error)
+	GetRecipientIds(event *models.Event) ([]uint, error)
+	// Waitlisted users getting a place freed by a bigger capacity are returned
+	Update(event *models.Event) (*models.Event, []models.EventRsvp, error)
+	Delete(id uint) error
+	// Waitlisted users getting the place freed by the user are returned
+	SaveRsvp(rsvp *models.EventRsvp) (*models.EventRsvp, []models.EventRsvp, error)
+	GetRsvpCounts(eventIds []uint) ([]models.EventRsvpCount, error)
+	// Oldest answer first, with the users
+	GetRsvps(eventId uint) ([]models.EventRsvp, error)
+}
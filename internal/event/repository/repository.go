@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/esgi-challenge/backend/internal/event"
+	"github.com/esgi-challenge/backend/internal/models"
+	"gorm.io/gorm"
+)
+
+type eventRepo struct {
+	db *gorm.DB
+}
+
+func NewEventRepository(db *gorm.DB) event.Repository {
+	return &eventRepo{db: db}
+}
+
+func (r *eventRepo) withRelations() *gorm.DB {
+	return r.db.Preload("Targets").Preload("Campus").Order("start_at, id")
+}
+
+func (r *eventRepo) Create(event *models.Event) (*models.Event, error) {
+	if err := r.db.Create(event).Error; err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+func (r *eventRepo) GetById(id uint) (*models.Event, error) {
+	var event models.Event
+
+	if err := r.withRelations().First(&event, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func withinPeriod(db *gorm.DB, period *models.EventPeriod) *gorm.DB {
+	db = db.Where("end_at > ?", period.From)
+
+	if period.To != nil {
+		db = db.Where("start_at < ?", *period.To)
+	}
+
+	return db
+}
+
+func (r *eventRepo) GetBySchoolId(schoolId uint, period *models.EventPeriod) (*[]models.Event, error) {
+	var events []models.Event
+
+	if err := withinPeriod(r.withRelations(), period).Find(&events, "school_id = ?", schoolId).Error; err != nil {
+		return nil, err
+	}
+
+	return &events, nil
+}
+
+func (r *eventRepo) GetVisible(schoolId uint, userId uint, period *models.EventPeriod) (*[]models.Event, error) {
+	var events []models.Event
+
+	err := withinPeriod(r.withRelations(), period).Preload("Rsvp", "user_id = ?", userId).Where(visibleCondition,
+		sql.Named("school", schoolId),
+		sql.Named("user", userId),
+	).Find(&events).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &events, nil
+}
+
+func (r *eventRepo) GetVisibleById(schoolId uint, userId uint, id uint) (*models.Event, error) {
+	var event models.Event
+
+	err := r.withRelations().Preload("Rsvp", "user_id = ?", userId).Where(visibleCondition,
+		sql.Named("school", schoolId),
+		sql.Named("user", userId),
+	).First(&event, id).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func (r *eventRepo) CountSchoolTargets(schoolId uint, kind models.EventTargetKind, ids []uint) (int64, error) {
+	var count int64
+	var model any
+
+	switch kind {
+	case models.EVENT_TARGET_CLASS:
+		model = &models.Class{}
+	case models.EVENT_TARGET_PATH:
+		model = &models.Path{}
+	default:
+		return 0, nil
+	}
+
+	if err := r.db.Model(model).Where("school_id = ? AND id IN ?", schoolId, ids).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *eventRepo) GetRecipientIds(event *models.Event) ([]uint, error) {
+	var ids []uint
+
+	err := r.db.Model(&models.User{}).Where(recipientsCondition,
+		sql.Named("school", event.SchoolId),
+		sql.Named("event", event.ID),
+	).Pluck("id", &ids).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *eventRepo) Update(event *models.Event) (*models.Event, []models.EventRsvp, error) {
+	var promoted []models.EventRsvp
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(event).
+			Select("title", "description", "campus_id", "start_at", "end_at", "capacity").
+			Updates(event).Error
+
+		if err != nil {
+			return err
+		}
+
+		promoted, err = promoteWaitlist(tx, event)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return event, promoted, nil
+}
+
+func (r *eventRepo) Delete(id uint) error {
+	if err := r.db.Delete(&models.Event{}, id).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBySchoolIdPeriod(t *testing.T) {
+	t.Parallel()
+
+	repo := setupWaitlistDB(t)
+	assert.NoError(t, repo.db.AutoMigrate(&models.Campus{}, &models.EventTarget{}))
+
+	now := time.Now()
+
+	for _, event := range []models.Event{
+		{Title: "past", SchoolId: 1, StartAt: now.Add(-3 * time.Hour), EndAt: now.Add(-2 * time.Hour)},
+		{Title: "ongoing", SchoolId: 1, StartAt: now.Add(-time.Hour), EndAt: now.Add(time.Hour)},
+		{Title: "next week", SchoolId: 1, StartAt: now.Add(7 * 24 * time.Hour), EndAt: now.Add(7*24*time.Hour + time.Hour)},
+		{Title: "other school", SchoolId: 2, StartAt: now.Add(time.Hour), EndAt: now.Add(2 * time.Hour)},
+	} {
+		_, err := repo.Create(&event)
+		assert.NoError(t, err)
+	}
+
+	titles := func(period *models.EventPeriod) []string {
+		events, err := repo.GetBySchoolId(1, period)
+		assert.NoError(t, err)
+
+		titles := []string{}
+		for _, event := range *events {
+			titles = append(titles, event.Title)
+		}
+
+		return titles
+	}
+
+	assert.Equal(t, []string{"ongoing", "next week"}, titles(&models.EventPeriod{From: now}))
+
+	tomorrow := now.Add(24 * time.Hour)
+	assert.Equal(t, []string{"ongoing"}, titles(&models.EventPeriod{From: now, To: &tomorrow}))
+
+	assert.Equal(t, []string{"past", "ongoing", "next week"}, titles(&models.EventPeriod{From: now.Add(-24 * time.Hour)}))
+}
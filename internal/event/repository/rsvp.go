@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"github.com/esgi-challenge/backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func countGoing(tx *gorm.DB, eventId uint) (int64, error) {
+	var count int64
+
+	err := tx.Model(&models.EventRsvp{}).
+		Where("event_id = ? AND status = ? AND NOT waitlisted", eventId, models.EVENT_RSVP_GOING).
+		Count(&count).Error
+
+	return count, err
+}
+
+// Give the free places to the oldest waitlisted answers, the event row must be locked
+func promoteWaitlist(tx *gorm.DB, event *models.Event) ([]models.EventRsvp, error) {
+	waitlist := []models.EventRsvp{}
+
+	query := tx.Where("event_id = ? AND status = ? AND waitlisted", event.ID, models.EVENT_RSVP_GOING).
+		Order("updated_at, user_id")
+
+	if event.Capacity > 0 {
+		going, err := countGoing(tx, event.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if going >= int64(event.Capacity) {
+			return waitlist, nil
+		}
+
+		query = query.Limit(int(int64(event.Capacity) - going))
+	}
+
+	if err := query.Find(&waitlist).Error; err != nil {
+		return nil, err
+	}
+
+	if len(waitlist) == 0 {
+		return waitlist, nil
+	}
+
+	userIds := make([]uint, 0, len(waitlist))
+	for i := range waitlist {
+		userIds = append(userIds, waitlist[i].UserId)
+		waitlist[i].Waitlisted = false
+	}
+
+	err := tx.Model(&models.EventRsvp{}).
+		Where("event_id = ? AND user_id IN ?", event.ID, userIds).
+		Update("waitlisted", false).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return waitlist, nil
+}
+
+// The event row is locked while the places are counted, answering the same status again
+// keeps the place in the waitlist
+func (r *eventRepo) SaveRsvp(rsvp *models.EventRsvp) (*models.EventRsvp, []models.EventRsvp, error) {
+	var promoted []models.EventRsvp
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var event models.Event
+
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&event, rsvp.EventId).Error; err != nil {
+			return err
+		}
+
+		var current []models.EventRsvp
+
+		if err := tx.Where("event_id = ? AND user_id = ?", rsvp.EventId, rsvp.UserId).Find(&current).Error; err != nil {
+			return err
+		}
+
+		if len(current) > 0 && current[0].Status == rsvp.Status {
+			*rsvp = current[0]
+			return nil
+		}
+
+		rsvp.Waitlisted = false
+
+		if rsvp.Status == models.EVENT_RSVP_GOING && event.Capacity > 0 {
+			going, err := countGoing(tx, event.ID)
+			if err != nil {
+				return err
+			}
+
+			rsvp.Waitlisted = going >= int64(event.Capacity)
+		}
+
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "event_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"status", "waitlisted", "updated_at"}),
+		}).Create(rsvp).Error
+
+		if err != nil {
+			return err
+		}
+
+		// Only a confirmed place frees one
+		if len(current) > 0 && current[0].Status == models.EVENT_RSVP_GOING && !current[0].Waitlisted {
+			promoted, err = promoteWaitlist(tx, &event)
+		}
+
+		return err
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rsvp, promoted, nil
+}
+
+func (r *eventRepo) GetRsvpCounts(eventIds []uint) ([]models.EventRsvpCount, error) {
+	counts := []models.EventRsvpCount{}
+
+	if len(eventIds) == 0 {
+		return counts, nil
+	}
+
+	err := r.db.Model(&models.EventRsvp{}).
+		Select("event_id, status, waitlisted, count(*) AS count").
+		Where("event_id IN ?", eventIds).
+		Group("event_id, status, waitlisted").
+		Scan(&counts).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (r *eventRepo) GetRsvps(eventId uint) ([]models.EventRsvp, error) {
+	rsvps := []models.EventRsvp{}
+
+	if err := r.db.Preload("User").Where("event_id = ?", eventId).Order("updated_at, user_id").Find(&rsvps).Error; err != nil {
+		return nil, err
+	}
+
+	return rsvps, nil
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// The waitlist is a state machine over several rows, it is tested on a real database
+func setupWaitlistDB(t *testing.T) *eventRepo {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+
+	assert.NoError(t, db.AutoMigrate(&models.Event{}, &models.EventRsvp{}))
+
+	return NewEventRepository(db).(*eventRepo)
+}
+
+func createEvent(t *testing.T, repo *eventRepo, capacity uint) *models.Event {
+	event, err := repo.Create(&models.Event{Title: "Open day", Capacity: capacity})
+	assert.NoError(t, err)
+
+	return event
+}
+
+func rsvp(t *testing.T, repo *eventRepo, event *models.Event, userId uint, status models.EventRsvpStatus) (*models.EventRsvp, []uint) {
+	saved, promoted, err := repo.SaveRsvp(&models.EventRsvp{EventId: event.ID, UserId: userId, Status: status})
+	assert.NoError(t, err)
+
+	promotedIds := []uint{}
+	for _, promotedRsvp := range promoted {
+		assert.False(t, promotedRsvp.Waitlisted)
+		promotedIds = append(promotedIds, promotedRsvp.UserId)
+	}
+
+	return saved, promotedIds
+}
+
+func waitlistedIds(t *testing.T, repo *eventRepo, event *models.Event) []uint {
+	rsvps, err := repo.GetRsvps(event.ID)
+	assert.NoError(t, err)
+
+	ids := []uint{}
+	for _, rsvp := range rsvps {
+		if rsvp.Waitlisted {
+			ids = append(ids, rsvp.UserId)
+		}
+	}
+
+	return ids
+}
+
+func TestSaveRsvpWaitlist(t *testing.T) {
+	t.Parallel()
+
+	repo := setupWaitlistDB(t)
+	event := createEvent(t, repo, 2)
+
+	saved, _ := rsvp(t, repo, event, 1, models.EVENT_RSVP_GOING)
+	assert.False(t, saved.Waitlisted)
+
+	saved, _ = rsvp(t, repo, event, 2, models.EVENT_RSVP_GOING)
+	assert.False(t, saved.Waitlisted)
+
+	// Full
+	saved, _ = rsvp(t, repo, event, 3, models.EVENT_RSVP_GOING)
+	assert.True(t, saved.Waitlisted)
+
+	saved, _ = rsvp(t, repo, event, 4, models.EVENT_RSVP_GOING)
+	assert.True(t, saved.Waitlisted)
+
+	// Maybe never takes a place
+	saved, _ = rsvp(t, repo, event, 5, models.EVENT_RSVP_MAYBE)
+	assert.False(t, saved.Waitlisted)
+
+	// Answering going again keeps the place in the waitlist
+	saved, promoted := rsvp(t, repo, event, 3, models.EVENT_RSVP_GOING)
+	assert.True(t, saved.Waitlisted)
+	assert.Empty(t, promoted)
+	assert.Equal(t, []uint{3, 4}, waitlistedIds(t, repo, event))
+
+	// A waitlisted user leaving frees nothing
+	_, promoted = rsvp(t, repo, event, 4, models.EVENT_RSVP_MAYBE)
+	assert.Empty(t, promoted)
+	assert.Equal(t, []uint{3}, waitlistedIds(t, repo, event))
+
+	// A confirmed user leaving gives the place to the oldest waitlisted answer
+	_, promoted = rsvp(t, repo, event, 1, models.EVENT_RSVP_NOT_GOING)
+	assert.Equal(t, []uint{3}, promoted)
+	assert.Empty(t, waitlistedIds(t, repo, event))
+
+	// Coming back once the place is taken goes to the waitlist
+	saved, _ = rsvp(t, repo, event, 1, models.EVENT_RSVP_GOING)
+	assert.True(t, saved.Waitlisted)
+
+	counts, err := repo.GetRsvpCounts([]uint{event.ID})
+	assert.NoError(t, err)
+
+	going, waitlisted := int64(0), int64(0)
+	for _, count := range counts {
+		if count.Waitlisted {
+			waitlisted += count.Count
+		} else if count.Status == models.EVENT_RSVP_GOING {
+			going += count.Count
+		}
+	}
+
+	assert.Equal(t, int64(2), going)
+	assert.Equal(t, int64(1), waitlisted)
+}
+
+func TestSaveRsvpWithoutCapacity(t *testing.T) {
+	t.Parallel()
+
+	repo := setupWaitlistDB(t)
+	event := createEvent(t, repo, 0)
+
+	for userId := uint(1); userId <= 5; userId++ {
+		saved, _ := rsvp(t, repo, event, userId, models.EVENT_RSVP_GOING)
+		assert.False(t, saved.Waitlisted)
+	}
+}
+
+func TestUpdateCapacityPromotesWaitlist(t *testing.T) {
+	t.Parallel()
+
+	repo := setupWaitlistDB(t)
+	event := createEvent(t, repo, 2)
+
+	for userId := uint(1); userId <= 5; userId++ {
+		rsvp(t, repo, event, userId, models.EVENT_RSVP_GOING)
+	}
+
+	assert.Equal(t, []uint{3, 4, 5}, waitlistedIds(t, repo, event))
+
+	// Smaller capacity, the users going keep their place
+	event.Capacity = 1
+	_, promoted, err := repo.Update(event)
+	assert.NoError(t, err)
+	assert.Empty(t, promoted)
+	assert.Equal(t, []uint{3, 4, 5}, waitlistedIds(t, repo, event))
+
+	// Bigger capacity, the oldest waitlisted answers get the new places
+	event.Capacity = 3
+	_, promoted, err = repo.Update(event)
+	assert.NoError(t, err)
+	assert.Len(t, promoted, 1)
+	assert.Equal(t, uint(3), promoted[0].UserId)
+	assert.Equal(t, []uint{4, 5}, waitlistedIds(t, repo, event))
+
+	// No limit anymore
+	event.Capacity = 0
+	_, promoted, err = repo.Update(event)
+	assert.NoError(t, err)
+	assert.Len(t, promoted, 2)
+	assert.Empty(t, waitlistedIds(t, repo, event))
+}
@@ -0,0 +1,60 @@
+package repository
+
+const (
+	// Whether the row of the outer "users" is in the audience of the row of the outer
+	// "events". Students belong to their class and its path, teachers to the classes and
+	// paths of the courses they teach
+	audienceCondition = `
+	NOT EXISTS (
+		SELECT 1
+		FROM event_targets AS target
+		WHERE
+			target.event_id = events.id
+			AND NOT EXISTS (
+				SELECT 1
+				FROM event_targets AS matched
+				WHERE
+					matched.event_id = events.id
+					AND matched.kind = target.kind
+					AND (
+						(matched.kind = 'userKind' AND matched.target_id = users.user_kind)
+						OR (matched.kind = 'class' AND matched.target_id IN (
+							SELECT users.class_refer
+							UNION
+							SELECT schedules.class
+							FROM schedules
+							JOIN courses ON courses.id = schedules.course AND courses.deleted_at IS NULL
+							WHERE schedules.deleted_at IS NULL AND courses.teacher_id = users.id
+						))
+						OR (matched.kind = 'path' AND matched.target_id IN (
+							SELECT classes.path_id
+							FROM classes
+							WHERE classes.deleted_at IS NULL AND classes.id = users.class_refer
+							UNION
+							SELECT courses."pathId"
+							FROM courses
+							WHERE courses.deleted_at IS NULL AND courses.teacher_id = users.id
+						))
+					)
+			)
+	)
+	`
+
+	visibleCondition = `
+	events.school_id = @school
+	AND EXISTS (
+		SELECT 1
+		FROM users
+		WHERE users.id = @user AND ` + audienceCondition + `
+	)
+	`
+
+	recipientsCondition = `
+	users.school_id = @school
+	AND EXISTS (
+		SELECT 1
+		FROM events
+		WHERE events.id = @event AND ` + audienceCondition + `
+	)
+	`
+)
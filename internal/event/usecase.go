@@ -0,0 +1,18 @@
+package event
+
+import (
+	"github.com/esgi-challenge/backend/internal/models"
+)
+
+type UseCase interface {
+	Create(user *models.User, event *models.Event) (*models.Event, error)
+	// Every event of the school for its administrator, the ones targeting the user for the
+	// others, within the period
+	GetAll(user *models.User, period *models.EventPeriod) (*[]models.Event, error)
+	GetById(user *models.User, id uint) (*models.Event, error)
+	Update(user *models.User, id uint, updatedEvent *models.Event) (*models.Event, error)
+	Delete(user *models.User, id uint) error
+	// Users going once the event is full are waitlisted, only students and teachers answer
+	Rsvp(user *models.User, id uint, status models.EventRsvpStatus) (*models.EventRsvp, error)
+	GetAttendees(user *models.User, id uint) (*models.EventAttendees, error)
+}
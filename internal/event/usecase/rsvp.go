@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+)
+
+func (u *eventUseCase) Rsvp(user *models.User, id uint, status models.EventRsvpStatus) (*models.EventRsvp, error) {
+	// The administrators run the events
+	if *user.UserKind != models.STUDENT && *user.UserKind != models.TEACHER {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "Only students and teachers can answer an event",
+		}
+	}
+
+	event, err := u.GetById(user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !event.EndAt.After(time.Now()) {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "This event is over",
+		}
+	}
+
+	rsvp, promoted, err := u.eventRepo.SaveRsvp(&models.EventRsvp{
+		EventId: event.ID,
+		UserId:  user.ID,
+		Status:  status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.notifyPromoted(event, promoted)
+
+	return rsvp, nil
+}
+
+func (u *eventUseCase) GetAttendees(user *models.User, id uint) (*models.EventAttendees, error) {
+	event, err := u.getOwned(user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	rsvps, err := u.eventRepo.GetRsvps(event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	attendees := models.EventAttendees{
+		Going:    []models.EventRsvp{},
+		Maybe:    []models.EventRsvp{},
+		NotGoing: []models.EventRsvp{},
+		Waitlist: []models.EventRsvp{},
+	}
+
+	for _, rsvp := range rsvps {
+		switch {
+		case rsvp.Waitlisted:
+			attendees.Waitlist = append(attendees.Waitlist, rsvp)
+		case rsvp.Status == models.EVENT_RSVP_GOING:
+			attendees.Going = append(attendees.Going, rsvp)
+		case rsvp.Status == models.EVENT_RSVP_MAYBE:
+			attendees.Maybe = append(attendees.Maybe, rsvp)
+		case rsvp.Status == models.EVENT_RSVP_NOT_GOING:
+			attendees.NotGoing = append(attendees.NotGoing, rsvp)
+		}
+	}
+
+	return &attendees, nil
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/event/mock"
+	"github.com/esgi-challenge/backend/internal/models"
+	notificationMock "github.com/esgi-challenge/backend/internal/notification/mock"
+	schoolMock "github.com/esgi-challenge/backend/internal/school/mock"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRsvp(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	mockNotificationUseCase := notificationMock.NewMockUseCase(ctrl)
+	logger := logger.NewLogger()
+	logger.InitLogger()
+
+	useCase := NewEventUseCase(nil, mockEventRepo, mockSchoolRepo, nil, mockNotificationUseCase, logger)
+
+	student := &models.User{GormModel: models.GormModel{ID: 7}, UserKind: models.NewUserKind(models.STUDENT)}
+	school := &models.School{GormModel: models.GormModel{ID: 3}}
+
+	t.Run("leaving notifies the promoted users", func(t *testing.T) {
+		event := newEvent()
+
+		mockSchoolRepo.EXPECT().GetByUser(student).Return(school, nil)
+		mockEventRepo.EXPECT().GetVisibleById(uint(3), student.ID, uint(5)).Return(event, nil)
+		mockEventRepo.EXPECT().GetRsvpCounts([]uint{5}).Return([]models.EventRsvpCount{}, nil)
+		mockEventRepo.EXPECT().SaveRsvp(&models.EventRsvp{EventId: 5, UserId: student.ID, Status: models.EVENT_RSVP_NOT_GOING}).
+			DoAndReturn(func(rsvp *models.EventRsvp) (*models.EventRsvp, []models.EventRsvp, error) {
+				return rsvp, []models.EventRsvp{{EventId: 5, UserId: 8}}, nil
+			})
+		mockNotificationUseCase.EXPECT().Notify([]uint{8}, gomock.Any()).DoAndReturn(func(userIds []uint, notification *models.Notification) error {
+			assert.Equal(t, models.NOTIFICATION_MESSAGE_EVENT_PROMOTED, notification.MessageKey)
+			assert.Equal(t, &event.ID, notification.RefId)
+			return nil
+		})
+
+		rsvp, err := useCase.Rsvp(student, 5, models.EVENT_RSVP_NOT_GOING)
+		assert.NoError(t, err)
+		assert.Equal(t, models.EVENT_RSVP_NOT_GOING, rsvp.Status)
+	})
+
+	t.Run("waitlisted when full", func(t *testing.T) {
+		mockSchoolRepo.EXPECT().GetByUser(student).Return(school, nil)
+		mockEventRepo.EXPECT().GetVisibleById(uint(3), student.ID, uint(5)).Return(newEvent(), nil)
+		mockEventRepo.EXPECT().GetRsvpCounts([]uint{5}).Return([]models.EventRsvpCount{}, nil)
+		mockEventRepo.EXPECT().SaveRsvp(gomock.Any()).DoAndReturn(func(rsvp *models.EventRsvp) (*models.EventRsvp, []models.EventRsvp, error) {
+			rsvp.Waitlisted = true
+			return rsvp, nil, nil
+		})
+
+		rsvp, err := useCase.Rsvp(student, 5, models.EVENT_RSVP_GOING)
+		assert.NoError(t, err)
+		assert.True(t, rsvp.Waitlisted)
+	})
+
+	t.Run("event over", func(t *testing.T) {
+		event := newEvent()
+		event.StartAt = time.Now().Add(-2 * time.Hour)
+		event.EndAt = time.Now().Add(-time.Hour)
+
+		mockSchoolRepo.EXPECT().GetByUser(student).Return(school, nil)
+		mockEventRepo.EXPECT().GetVisibleById(uint(3), student.ID, uint(5)).Return(event, nil)
+		mockEventRepo.EXPECT().GetRsvpCounts([]uint{5}).Return([]models.EventRsvpCount{}, nil)
+
+		rsvp, err := useCase.Rsvp(student, 5, models.EVENT_RSVP_GOING)
+		assert.Nil(t, rsvp)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "This event is over",
+		}, err)
+	})
+
+	t.Run("administrators don't answer", func(t *testing.T) {
+		admin := &models.User{GormModel: models.GormModel{ID: 1}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+
+		rsvp, err := useCase.Rsvp(admin, 5, models.EVENT_RSVP_GOING)
+		assert.Nil(t, rsvp)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "Only students and teachers can answer an event",
+		}, err)
+	})
+}
+
+func TestGetAttendees(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewEventUseCase(nil, mockEventRepo, mockSchoolRepo, nil, nil, logger)
+
+	admin := &models.User{GormModel: models.GormModel{ID: 1}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+
+	mockEventRepo.EXPECT().GetById(uint(5)).Return(newEvent(), nil)
+	mockSchoolRepo.EXPECT().GetById(uint(3)).Return(&models.School{GormModel: models.GormModel{ID: 3}, UserID: 1}, nil)
+	mockEventRepo.EXPECT().GetRsvps(uint(5)).Return([]models.EventRsvp{
+		{UserId: 7, Status: models.EVENT_RSVP_GOING},
+		{UserId: 8, Status: models.EVENT_RSVP_MAYBE},
+		{UserId: 9, Status: models.EVENT_RSVP_GOING, Waitlisted: true},
+		{UserId: 10, Status: models.EVENT_RSVP_NOT_GOING},
+		{UserId: 11, Status: models.EVENT_RSVP_GOING, Waitlisted: true},
+	}, nil)
+
+	attendees, err := useCase.GetAttendees(admin, 5)
+	assert.NoError(t, err)
+	assert.Len(t, attendees.Going, 1)
+	assert.Len(t, attendees.Maybe, 1)
+	assert.Len(t, attendees.NotGoing, 1)
+	assert.Equal(t, uint(9), attendees.Waitlist[0].UserId)
+	assert.Equal(t, uint(11), attendees.Waitlist[1].UserId)
+}
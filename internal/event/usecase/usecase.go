@@ -0,0 +1,342 @@
+package usecase
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/campus"
+	"github.com/esgi-challenge/backend/internal/event"
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/notification"
+	"github.com/esgi-challenge/backend/internal/school"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+)
+
+type eventUseCase struct {
+	eventRepo           event.Repository
+	schoolRepo          school.Repository
+	campusRepo          campus.Repository
+	notificationUseCase notification.UseCase
+	cfg                 *config.Config
+	logger              logger.Logger
+}
+
+func NewEventUseCase(cfg *config.Config, eventRepo event.Repository, schoolRepo school.Repository, campusRepo campus.Repository, notificationUseCase notification.UseCase, logger logger.Logger) event.UseCase {
+	return &eventUseCase{
+		cfg:                 cfg,
+		eventRepo:           eventRepo,
+		schoolRepo:          schoolRepo,
+		campusRepo:          campusRepo,
+		notificationUseCase: notificationUseCase,
+		logger:              logger,
+	}
+}
+
+func (u *eventUseCase) Create(user *models.User, event *models.Event) (*models.Event, error) {
+	school, err := u.schoolRepo.GetById(event.SchoolId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if school.UserID != user.ID {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This school is not yours",
+		}
+	}
+
+	if err := u.checkEvent(event); err != nil {
+		return nil, err
+	}
+
+	if err := u.checkTargets(event); err != nil {
+		return nil, err
+	}
+
+	event, err = u.eventRepo.Create(event)
+	if err != nil {
+		return nil, err
+	}
+
+	u.notifyAudience(event)
+
+	return u.eventRepo.GetById(event.ID)
+}
+
+// The event must end after it starts, on a campus of its school
+func (u *eventUseCase) checkEvent(event *models.Event) error {
+	if !event.EndAt.After(event.StartAt) {
+		return errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The event must end after it starts",
+		}
+	}
+
+	campus, err := u.campusRepo.GetById(event.CampusId)
+	if err != nil {
+		return err
+	}
+
+	if campus.SchoolId != event.SchoolId {
+		return errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The campus is not from your school",
+		}
+	}
+
+	return nil
+}
+
+// Classes and paths must be of the school of the event
+func (u *eventUseCase) checkTargets(event *models.Event) error {
+	ids := map[models.EventTargetKind][]uint{}
+
+	for _, target := range event.Targets {
+		ids[target.Kind] = append(ids[target.Kind], target.TargetId)
+	}
+
+	for kind, targetIds := range ids {
+		slices.Sort(targetIds)
+		targetIds = slices.Compact(targetIds)
+
+		if kind == models.EVENT_TARGET_USER_KIND {
+			for _, id := range targetIds {
+				if id != models.STUDENT && id != models.TEACHER {
+					return errorHandler.HttpError{
+						HttpStatus: http.StatusBadRequest,
+						HttpError:  "Only students and teachers can be targeted",
+					}
+				}
+			}
+
+			continue
+		}
+
+		count, err := u.eventRepo.CountSchoolTargets(event.SchoolId, kind, targetIds)
+		if err != nil {
+			return err
+		}
+
+		if count != int64(len(targetIds)) {
+			return errorHandler.HttpError{
+				HttpStatus: http.StatusBadRequest,
+				HttpError:  "A targeted " + string(kind) + " is not from your school",
+			}
+		}
+	}
+
+	return nil
+}
+
+// The event is saved even if the audience could not be notified
+func (u *eventUseCase) notifyAudience(event *models.Event) {
+	ids, err := u.eventRepo.GetRecipientIds(event)
+	if err != nil {
+		u.logger.Errorf("Notification: event %d: %v", event.ID, err)
+		return
+	}
+
+	u.notify(ids, event, models.NOTIFICATION_MESSAGE_EVENT_CREATED)
+}
+
+func (u *eventUseCase) notify(userIds []uint, event *models.Event, key models.NotificationMessageKey) {
+	if len(userIds) == 0 {
+		return
+	}
+
+	err := u.notificationUseCase.Notify(userIds, &models.Notification{
+		Type:       models.NOTIFICATION_EVENT,
+		MessageKey: key,
+		MessageParams: map[string]string{
+			"title":   event.Title,
+			"startAt": event.StartAt.In(u.location(event)).Format(models.NotificationTimeLayout),
+		},
+		RefId: &event.ID,
+	})
+	if err != nil {
+		u.logger.Errorf("Notification: event %d: %v", event.ID, err)
+	}
+}
+
+// Timezone of the campus of the event, it is not loaded on the created ones
+func (u *eventUseCase) location(event *models.Event) *time.Location {
+	if event.Campus.ID == event.CampusId {
+		return event.Campus.TimeLocation()
+	}
+
+	campus, err := u.campusRepo.GetById(event.CampusId)
+	if err != nil {
+		u.logger.Errorf("Notification: event %d campus: %v", event.ID, err)
+		return models.TimeLocation(models.DefaultTimezone)
+	}
+
+	return campus.TimeLocation()
+}
+
+// Waitlisted users are told when they get a place
+func (u *eventUseCase) notifyPromoted(event *models.Event, promoted []models.EventRsvp) {
+	ids := make([]uint, 0, len(promoted))
+
+	for _, rsvp := range promoted {
+		ids = append(ids, rsvp.UserId)
+	}
+
+	u.notify(ids, event, models.NOTIFICATION_MESSAGE_EVENT_PROMOTED)
+}
+
+// Answers counted by status, waitlisted users are not going yet
+func (u *eventUseCase) withCounts(events []models.Event) error {
+	ids := make([]uint, 0, len(events))
+	index := map[uint]int{}
+
+	for i, event := range events {
+		ids = append(ids, event.ID)
+		index[event.ID] = i
+	}
+
+	counts, err := u.eventRepo.GetRsvpCounts(ids)
+	if err != nil {
+		return err
+	}
+
+	for _, count := range counts {
+		event := &events[index[count.EventId]]
+
+		switch {
+		case count.Waitlisted:
+			event.Waitlisted += count.Count
+		case count.Status == models.EVENT_RSVP_GOING:
+			event.Going += count.Count
+		case count.Status == models.EVENT_RSVP_MAYBE:
+			event.Maybe += count.Count
+		case count.Status == models.EVENT_RSVP_NOT_GOING:
+			event.NotGoing += count.Count
+		}
+	}
+
+	return nil
+}
+
+func (u *eventUseCase) GetAll(user *models.User, period *models.EventPeriod) (*[]models.Event, error) {
+	school, err := u.schoolRepo.GetByUser(user)
+	if err != nil {
+		return nil, err
+	}
+
+	var events *[]models.Event
+
+	if school.UserID == user.ID {
+		events, err = u.eventRepo.GetBySchoolId(school.ID, period)
+	} else {
+		events, err = u.eventRepo.GetVisible(school.ID, user.ID, period)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.withCounts(*events); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (u *eventUseCase) GetById(user *models.User, id uint) (*models.Event, error) {
+	school, err := u.schoolRepo.GetByUser(user)
+	if err != nil {
+		return nil, err
+	}
+
+	var event *models.Event
+
+	if school.UserID == user.ID {
+		event, err = u.eventRepo.GetById(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if event.SchoolId != school.ID {
+			return nil, errorHandler.HttpError{
+				HttpStatus: http.StatusForbidden,
+				HttpError:  "This event is not yours",
+			}
+		}
+	} else {
+		event, err = u.eventRepo.GetVisibleById(school.ID, user.ID, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	events := []models.Event{*event}
+
+	if err := u.withCounts(events); err != nil {
+		return nil, err
+	}
+
+	return &events[0], nil
+}
+
+// Event of the school of the administrator
+func (u *eventUseCase) getOwned(user *models.User, id uint) (*models.Event, error) {
+	event, err := u.eventRepo.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+
+	school, err := u.schoolRepo.GetById(event.SchoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	if school.UserID != user.ID {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This event is not yours",
+		}
+	}
+
+	return event, nil
+}
+
+// Waitlisted users get the places added by a bigger capacity, the ones already going
+// keep theirs if it gets smaller
+func (u *eventUseCase) Update(user *models.User, id uint, updatedEvent *models.Event) (*models.Event, error) {
+	event, err := u.getOwned(user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedEvent.ID = event.ID
+	updatedEvent.SchoolId = event.SchoolId
+
+	if err := u.checkEvent(updatedEvent); err != nil {
+		return nil, err
+	}
+
+	_, promoted, err := u.eventRepo.Update(updatedEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	u.notifyPromoted(updatedEvent, promoted)
+
+	return u.GetById(user, id)
+}
+
+func (u *eventUseCase) Delete(user *models.User, id uint) error {
+	// Check not needed but added to handle a not found error because gorm do not return
+	// error if delete on a row that does not exist
+	_, err := u.getOwned(user, id)
+
+	if err != nil {
+		return err
+	}
+
+	return u.eventRepo.Delete(id)
+}
@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	campusMock "github.com/esgi-challenge/backend/internal/campus/mock"
+	"github.com/esgi-challenge/backend/internal/event/mock"
+	"github.com/esgi-challenge/backend/internal/models"
+	notificationMock "github.com/esgi-challenge/backend/internal/notification/mock"
+	schoolMock "github.com/esgi-challenge/backend/internal/school/mock"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// Event of the school 3 on the campus 4 starting tomorrow
+func newEvent() *models.Event {
+	startAt := time.Now().Add(24 * time.Hour)
+
+	return &models.Event{
+		GormModel: models.GormModel{ID: 5},
+		Title:     "Open day",
+		SchoolId:  3,
+		CampusId:  4,
+		Campus:    models.Campus{GormModel: models.GormModel{ID: 4}, SchoolId: 3, Timezone: models.DefaultTimezone},
+		StartAt:   startAt,
+		EndAt:     startAt.Add(2 * time.Hour),
+		Capacity:  2,
+	}
+}
+
+func TestCreateEvent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	mockCampusRepo := campusMock.NewMockRepository(ctrl)
+	mockNotificationUseCase := notificationMock.NewMockUseCase(ctrl)
+	logger := logger.NewLogger()
+	logger.InitLogger()
+
+	useCase := NewEventUseCase(nil, mockEventRepo, mockSchoolRepo, mockCampusRepo, mockNotificationUseCase, logger)
+
+	user := &models.User{GormModel: models.GormModel{ID: 1}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+	school := &models.School{GormModel: models.GormModel{ID: 3}, UserID: 1}
+	campus := &models.Campus{GormModel: models.GormModel{ID: 4}, SchoolId: 3, Timezone: "America/New_York"}
+
+	t.Run("success notifies the audience", func(t *testing.T) {
+		event := newEvent()
+		// Not loaded on the created events
+		event.Campus = models.Campus{}
+
+		mockSchoolRepo.EXPECT().GetById(uint(3)).Return(school, nil)
+		mockCampusRepo.EXPECT().GetById(uint(4)).Return(campus, nil).Times(2)
+		mockEventRepo.EXPECT().Create(event).Return(event, nil)
+		mockEventRepo.EXPECT().GetRecipientIds(event).Return([]uint{7, 8}, nil)
+		mockNotificationUseCase.EXPECT().Notify([]uint{7, 8}, gomock.Any()).DoAndReturn(func(userIds []uint, notification *models.Notification) error {
+			assert.Equal(t, models.NOTIFICATION_EVENT, notification.Type)
+			assert.Equal(t, models.NOTIFICATION_MESSAGE_EVENT_CREATED, notification.MessageKey)
+			assert.Equal(t, "Open day", notification.MessageParams["title"])
+			assert.Equal(t, event.StartAt.In(campus.TimeLocation()).Format(models.NotificationTimeLayout), notification.MessageParams["startAt"])
+			return nil
+		})
+		mockEventRepo.EXPECT().GetById(uint(5)).Return(event, nil)
+
+		created, err := useCase.Create(user, event)
+		assert.NoError(t, err)
+		assert.Equal(t, event, created)
+	})
+
+	t.Run("ends before it starts", func(t *testing.T) {
+		event := newEvent()
+		event.EndAt = event.StartAt
+
+		mockSchoolRepo.EXPECT().GetById(uint(3)).Return(school, nil)
+
+		created, err := useCase.Create(user, event)
+		assert.Nil(t, created)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The event must end after it starts",
+		}, err)
+	})
+
+	t.Run("campus of another school", func(t *testing.T) {
+		mockSchoolRepo.EXPECT().GetById(uint(3)).Return(school, nil)
+		mockCampusRepo.EXPECT().GetById(uint(4)).Return(&models.Campus{SchoolId: 9}, nil)
+
+		created, err := useCase.Create(user, newEvent())
+		assert.Nil(t, created)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The campus is not from your school",
+		}, err)
+	})
+
+	t.Run("school not owned by user", func(t *testing.T) {
+		other := &models.User{GormModel: models.GormModel{ID: 2}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+
+		mockSchoolRepo.EXPECT().GetById(uint(3)).Return(school, nil)
+
+		created, err := useCase.Create(other, newEvent())
+		assert.Nil(t, created)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This school is not yours",
+		}, err)
+	})
+}
+
+func TestUpdateEvent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	mockCampusRepo := campusMock.NewMockRepository(ctrl)
+	mockNotificationUseCase := notificationMock.NewMockUseCase(ctrl)
+	logger := logger.NewLogger()
+	logger.InitLogger()
+
+	useCase := NewEventUseCase(nil, mockEventRepo, mockSchoolRepo, mockCampusRepo, mockNotificationUseCase, logger)
+
+	user := &models.User{GormModel: models.GormModel{ID: 1}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+	school := &models.School{GormModel: models.GormModel{ID: 3}, UserID: 1}
+	campus := &models.Campus{GormModel: models.GormModel{ID: 4}, SchoolId: 3}
+
+	t.Run("bigger capacity notifies the promoted users", func(t *testing.T) {
+		event := newEvent()
+		updated := newEvent()
+		updated.ID = 0
+		updated.Capacity = 4
+
+		mockEventRepo.EXPECT().GetById(uint(5)).Return(event, nil).Times(2)
+		mockSchoolRepo.EXPECT().GetById(uint(3)).Return(school, nil)
+		mockCampusRepo.EXPECT().GetById(uint(4)).Return(campus, nil)
+		mockEventRepo.EXPECT().Update(updated).Return(updated, []models.EventRsvp{{UserId: 8}, {UserId: 9}}, nil)
+		mockNotificationUseCase.EXPECT().Notify([]uint{8, 9}, gomock.Any()).DoAndReturn(func(userIds []uint, notification *models.Notification) error {
+			assert.Equal(t, models.NOTIFICATION_MESSAGE_EVENT_PROMOTED, notification.MessageKey)
+			return nil
+		})
+		mockSchoolRepo.EXPECT().GetByUser(user).Return(school, nil)
+		mockEventRepo.EXPECT().GetRsvpCounts([]uint{5}).Return([]models.EventRsvpCount{
+			{EventId: 5, Status: models.EVENT_RSVP_GOING, Count: 4},
+			{EventId: 5, Status: models.EVENT_RSVP_GOING, Waitlisted: true, Count: 1},
+		}, nil)
+
+		result, err := useCase.Update(user, 5, updated)
+		assert.NoError(t, err)
+		assert.Equal(t, uint(5), updated.ID)
+		assert.Equal(t, uint(3), updated.SchoolId)
+		assert.Equal(t, int64(4), result.Going)
+		assert.Equal(t, int64(1), result.Waitlisted)
+	})
+
+	t.Run("nobody promoted", func(t *testing.T) {
+		event := newEvent()
+		updated := newEvent()
+		updated.Capacity = 1
+
+		mockEventRepo.EXPECT().GetById(uint(5)).Return(event, nil).Times(2)
+		mockSchoolRepo.EXPECT().GetById(uint(3)).Return(school, nil)
+		mockCampusRepo.EXPECT().GetById(uint(4)).Return(campus, nil)
+		mockEventRepo.EXPECT().Update(updated).Return(updated, []models.EventRsvp{}, nil)
+		mockSchoolRepo.EXPECT().GetByUser(user).Return(school, nil)
+		mockEventRepo.EXPECT().GetRsvpCounts([]uint{5}).Return([]models.EventRsvpCount{}, nil)
+
+		_, err := useCase.Update(user, 5, updated)
+		assert.NoError(t, err)
+	})
+
+	t.Run("event not owned by user", func(t *testing.T) {
+		other := &models.User{GormModel: models.GormModel{ID: 2}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+
+		mockEventRepo.EXPECT().GetById(uint(5)).Return(newEvent(), nil)
+		mockSchoolRepo.EXPECT().GetById(uint(3)).Return(school, nil)
+
+		result, err := useCase.Update(other, 5, newEvent())
+		assert.Nil(t, result)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This event is not yours",
+		}, err)
+	})
+}
+
+func TestGetAllEvents(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockEventRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewEventUseCase(nil, mockEventRepo, mockSchoolRepo, nil, nil, logger)
+
+	school := &models.School{GormModel: models.GormModel{ID: 3}, UserID: 1}
+	tomorrow := time.Now().Add(24 * time.Hour)
+	period := &models.EventPeriod{From: time.Now(), To: &tomorrow}
+
+	t.Run("administrator gets every event of the school", func(t *testing.T) {
+		admin := &models.User{GormModel: models.GormModel{ID: 1}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+
+		mockSchoolRepo.EXPECT().GetByUser(admin).Return(school, nil)
+		mockEventRepo.EXPECT().GetBySchoolId(uint(3), period).Return(&[]models.Event{*newEvent()}, nil)
+		mockEventRepo.EXPECT().GetRsvpCounts([]uint{5}).Return([]models.EventRsvpCount{
+			{EventId: 5, Status: models.EVENT_RSVP_MAYBE, Count: 2},
+			{EventId: 5, Status: models.EVENT_RSVP_NOT_GOING, Count: 1},
+		}, nil)
+
+		events, err := useCase.GetAll(admin, period)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), (*events)[0].Maybe)
+		assert.Equal(t, int64(1), (*events)[0].NotGoing)
+	})
+
+	t.Run("student gets the visible ones", func(t *testing.T) {
+		student := &models.User{GormModel: models.GormModel{ID: 7}, UserKind: models.NewUserKind(models.STUDENT)}
+
+		mockSchoolRepo.EXPECT().GetByUser(student).Return(school, nil)
+		mockEventRepo.EXPECT().GetVisible(uint(3), student.ID, period).Return(&[]models.Event{}, nil)
+		mockEventRepo.EXPECT().GetRsvpCounts([]uint{}).Return([]models.EventRsvpCount{}, nil)
+
+		events, err := useCase.GetAll(student, period)
+		assert.NoError(t, err)
+		assert.Empty(t, *events)
+	})
+}
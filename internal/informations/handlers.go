@@ -8,5 +8,11 @@ type Handlers interface {
 	Create() gin.HandlerFunc
 	GetAll() gin.HandlerFunc
 	GetById() gin.HandlerFunc
+	Update() gin.HandlerFunc
+	GetRevisions() gin.HandlerFunc
 	Delete() gin.HandlerFunc
+	MarkRead() gin.HandlerFunc
+	Acknowledge() gin.HandlerFunc
+	GetReceipts() gin.HandlerFunc
+	SendReminder() gin.HandlerFunc
 }
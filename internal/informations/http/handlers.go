@@ -3,6 +3,7 @@ package http
 import (
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/esgi-challenge/backend/config"
 	"github.com/esgi-challenge/backend/internal/informations"
@@ -28,7 +29,7 @@ func NewInformationsHandlers(cfg *config.Config, informationsUseCase information
 // Create
 //
 //	@Summary		Create new informations
-//	@Description	create new informations
+//	@Description	create new informations, targeted at classes, paths, campuses or user kinds and published now or at publishAt. The audience is notified once published
 //	@Tags			Informations
 //	@Accept			json
 //	@Produce		json
@@ -66,6 +67,25 @@ func (u *informationsHandlers) Create() gin.HandlerFunc {
 			Title:       informationsCreate.Title,
 			Description: informationsCreate.Description,
 			SchoolId:    school.ID,
+			Pinned:      informationsCreate.Pinned,
+			RequiresAck: informationsCreate.RequiresAck,
+			DocumentId:  informationsCreate.DocumentId,
+		}
+
+		if informationsCreate.PublishAt != nil {
+			informations.PublishAt = time.Unix(int64(*informationsCreate.PublishAt), 0)
+		}
+
+		if informationsCreate.ExpiresAt != nil {
+			expiresAt := time.Unix(int64(*informationsCreate.ExpiresAt), 0)
+			informations.ExpiresAt = &expiresAt
+		}
+
+		for _, target := range informationsCreate.Targets {
+			informations.Targets = append(informations.Targets, models.InformationsTarget{
+				Kind:     target.Kind,
+				TargetId: *target.TargetId,
+			})
 		}
 
 		informationsDb, err := u.informationsUseCase.Create(user, informations)
@@ -83,7 +103,7 @@ func (u *informationsHandlers) Create() gin.HandlerFunc {
 // Read
 //
 //	@Summary		Get all informations from schoolId
-//	@Description	Get all informations from schoolId
+//	@Description	Get the informations of the school, pinned first. Students and teachers only get the published and not expired ones targeting them
 //	@Tags			Informations
 //	@Produce		json
 //	@Success		200	{object}	[]models.Informations
@@ -152,6 +172,115 @@ func (u *informationsHandlers) GetById() gin.HandlerFunc {
 	}
 }
 
+// Update
+//
+//	@Summary		Update informations
+//	@Description	Update the informations, the description is Markdown. The previous title and description are kept in the revisions when they change
+//	@Tags			Informations
+//	@Accept			json
+//	@Produce		json
+//	@Param			id				path		int							true	"id"
+//	@Param			informations	body		models.InformationsUpdate	true	"Informations infos"
+//	@Success		200				{object}	models.Informations
+//	@Failure		400				{object}	errorHandler.HttpErr
+//	@Failure		403				{object}	errorHandler.HttpErr
+//	@Failure		404				{object}	errorHandler.HttpErr
+//	@Failure		500				{object}	errorHandler.HttpErr
+//	@Router			/informations/{id} [put]
+func (u *informationsHandlers) Update() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.ADMINISTRATOR)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		var body models.InformationsUpdate
+
+		informationsUpdate, err := request.ValidateJSON(body, ctx)
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		informations := &models.Informations{
+			Title:       informationsUpdate.Title,
+			Description: informationsUpdate.Description,
+			Pinned:      informationsUpdate.Pinned,
+			RequiresAck: informationsUpdate.RequiresAck,
+			DocumentId:  informationsUpdate.DocumentId,
+		}
+
+		if informationsUpdate.ExpiresAt != nil {
+			expiresAt := time.Unix(int64(*informationsUpdate.ExpiresAt), 0)
+			informations.ExpiresAt = &expiresAt
+		}
+
+		updatedInformations, err := u.informationsUseCase.Update(user, uint(idInt), informations)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, updatedInformations)
+	}
+}
+
+// Read
+//
+//	@Summary		Get informations revisions
+//	@Description	Get the informations with its previous titles and descriptions, oldest first
+//	@Tags			Informations
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{object}	models.InformationsHistory
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		404	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/informations/{id}/revisions [get]
+func (u *informationsHandlers) GetRevisions() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		history, err := u.informationsUseCase.GetRevisions(user, uint(idInt))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, history)
+	}
+}
+
 // Delete
 //
 //	@Summary		Delete informations by id
@@ -0,0 +1,173 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/informations/mock"
+	"github.com/esgi-challenge/backend/internal/models"
+	schoolMock "github.com/esgi-challenge/backend/internal/school/mock"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/jwt"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAcknowledge(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewLogger()
+	logger.InitLogger()
+	mockUseCase := mock.NewMockUseCase(ctrl)
+	mockSchoolUseCase := schoolMock.NewMockUseCase(ctrl)
+	cfg := &config.Config{JwtSecret: "secret"}
+	handlers := NewInformationsHandlers(cfg, mockUseCase, mockSchoolUseCase, logger)
+
+	studentUser := &models.User{UserKind: models.NewUserKind(models.STUDENT)}
+	token, _ := jwt.Generate(cfg.JwtSecret, studentUser)
+
+	t.Run("Acknowledge request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/informations/1/acknowledge", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		mockUseCase.EXPECT().Acknowledge(gomock.Any(), uint(1)).Return(&models.InformationsReceipt{InformationsId: 1}, nil)
+
+		handler := handlers.Acknowledge()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Acknowledge request not required", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/informations/1/acknowledge", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		mockUseCase.EXPECT().Acknowledge(gomock.Any(), uint(1)).Return(nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "This information does not require an acknowledgement",
+		})
+
+		handler := handlers.Acknowledge()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
+
+func TestGetReceipts(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewLogger()
+	logger.InitLogger()
+	mockUseCase := mock.NewMockUseCase(ctrl)
+	mockSchoolUseCase := schoolMock.NewMockUseCase(ctrl)
+	cfg := &config.Config{JwtSecret: "secret"}
+	handlers := NewInformationsHandlers(cfg, mockUseCase, mockSchoolUseCase, logger)
+
+	adminUser := &models.User{UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+	token, _ := jwt.Generate(cfg.JwtSecret, adminUser)
+
+	studentUser := &models.User{UserKind: models.NewUserKind(models.STUDENT)}
+	studentToken, _ := jwt.Generate(cfg.JwtSecret, studentUser)
+
+	t.Run("GetReceipts request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/informations/1/receipts", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		mockUseCase.EXPECT().GetReceipts(gomock.Any(), uint(1)).Return(&models.InformationsReceipts{Audience: 3, Read: 2}, nil)
+
+		handler := handlers.GetReceipts()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("GetReceipts request not administrator", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/informations/1/receipts", nil)
+		req.Header.Set("Authorization", "Bearer "+studentToken)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		handler := handlers.GetReceipts()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+}
+
+func TestSendReminder(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewLogger()
+	logger.InitLogger()
+	mockUseCase := mock.NewMockUseCase(ctrl)
+	mockSchoolUseCase := schoolMock.NewMockUseCase(ctrl)
+	cfg := &config.Config{JwtSecret: "secret"}
+	handlers := NewInformationsHandlers(cfg, mockUseCase, mockSchoolUseCase, logger)
+
+	adminUser := &models.User{UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+	token, _ := jwt.Generate(cfg.JwtSecret, adminUser)
+
+	t.Run("SendReminder request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/informations/1/remind", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		mockUseCase.EXPECT().SendReminder(gomock.Any(), uint(1)).Return(nil)
+
+		handler := handlers.SendReminder()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("SendReminder request server error", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/informations/1/remind", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		mockUseCase.EXPECT().SendReminder(gomock.Any(), uint(1)).Return(errors.New("random server error"))
+
+		handler := handlers.SendReminder()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+	})
+}
@@ -9,5 +9,11 @@ func SetupInformationsRoutes(informationsGroup *gin.RouterGroup, h informations.
 	informationsGroup.POST("", h.Create())
 	informationsGroup.GET("", h.GetAll())
 	informationsGroup.GET("/:id", h.GetById())
+	informationsGroup.PUT("/:id", h.Update())
 	informationsGroup.DELETE("/:id", h.Delete())
+	informationsGroup.GET("/:id/revisions", h.GetRevisions())
+	informationsGroup.POST("/:id/read", h.MarkRead())
+	informationsGroup.POST("/:id/acknowledge", h.Acknowledge())
+	informationsGroup.GET("/:id/receipts", h.GetReceipts())
+	informationsGroup.POST("/:id/remind", h.SendReminder())
 }
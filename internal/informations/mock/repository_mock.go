@@ -11,6 +11,7 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	models "github.com/esgi-challenge/backend/internal/models"
 	gomock "go.uber.org/mock/gomock"
@@ -39,6 +40,52 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ClaimNotification mocks base method.
+func (m *MockRepository) ClaimNotification(id uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimNotification", id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimNotification indicates an expected call of ClaimNotification.
+func (mr *MockRepositoryMockRecorder) ClaimNotification(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimNotification", reflect.TypeOf((*MockRepository)(nil).ClaimNotification), id)
+}
+
+// CountReceipts mocks base method.
+func (m *MockRepository) CountReceipts(informations *models.Informations) (int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountReceipts", informations)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CountReceipts indicates an expected call of CountReceipts.
+func (mr *MockRepositoryMockRecorder) CountReceipts(informations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountReceipts", reflect.TypeOf((*MockRepository)(nil).CountReceipts), informations)
+}
+
+// CountSchoolTargets mocks base method.
+func (m *MockRepository) CountSchoolTargets(schoolId uint, kind models.InformationsTargetKind, ids []uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSchoolTargets", schoolId, kind, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSchoolTargets indicates an expected call of CountSchoolTargets.
+func (mr *MockRepositoryMockRecorder) CountSchoolTargets(schoolId, kind, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSchoolTargets", reflect.TypeOf((*MockRepository)(nil).CountSchoolTargets), schoolId, kind, ids)
+}
+
 // Create mocks base method.
 func (m *MockRepository) Create(informations *models.Informations) (*models.Informations, error) {
 	m.ctrl.T.Helper()
@@ -113,17 +160,122 @@ func (mr *MockRepositoryMockRecorder) GetBySchoolId(schoolId any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySchoolId", reflect.TypeOf((*MockRepository)(nil).GetBySchoolId), schoolId)
 }
 
-// GetBySchoolIdAndId mocks base method.
-func (m *MockRepository) GetBySchoolIdAndId(schoolId, informationId uint) (*models.Informations, error) {
+// GetPendingNotification mocks base method.
+func (m *MockRepository) GetPendingNotification(now time.Time) (*[]models.Informations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingNotification", now)
+	ret0, _ := ret[0].(*[]models.Informations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingNotification indicates an expected call of GetPendingNotification.
+func (mr *MockRepositoryMockRecorder) GetPendingNotification(now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingNotification", reflect.TypeOf((*MockRepository)(nil).GetPendingNotification), now)
+}
+
+// GetPendingUsers mocks base method.
+func (m *MockRepository) GetPendingUsers(informations *models.Informations, read bool) ([]models.InformationsPendingUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingUsers", informations, read)
+	ret0, _ := ret[0].([]models.InformationsPendingUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingUsers indicates an expected call of GetPendingUsers.
+func (mr *MockRepositoryMockRecorder) GetPendingUsers(informations, read any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingUsers", reflect.TypeOf((*MockRepository)(nil).GetPendingUsers), informations, read)
+}
+
+// GetRecipientIds mocks base method.
+func (m *MockRepository) GetRecipientIds(informations *models.Informations) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecipientIds", informations)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecipientIds indicates an expected call of GetRecipientIds.
+func (mr *MockRepositoryMockRecorder) GetRecipientIds(informations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipientIds", reflect.TypeOf((*MockRepository)(nil).GetRecipientIds), informations)
+}
+
+// GetRevisions mocks base method.
+func (m *MockRepository) GetRevisions(informationsId uint) (*[]models.InformationsRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", informationsId)
+	ret0, _ := ret[0].(*[]models.InformationsRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockRepositoryMockRecorder) GetRevisions(informationsId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockRepository)(nil).GetRevisions), informationsId)
+}
+
+// GetVisible mocks base method.
+func (m *MockRepository) GetVisible(schoolId, userId uint, now time.Time) (*[]models.Informations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVisible", schoolId, userId, now)
+	ret0, _ := ret[0].(*[]models.Informations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVisible indicates an expected call of GetVisible.
+func (mr *MockRepositoryMockRecorder) GetVisible(schoolId, userId, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisible", reflect.TypeOf((*MockRepository)(nil).GetVisible), schoolId, userId, now)
+}
+
+// GetVisibleById mocks base method.
+func (m *MockRepository) GetVisibleById(schoolId, userId, id uint, now time.Time) (*models.Informations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVisibleById", schoolId, userId, id, now)
+	ret0, _ := ret[0].(*models.Informations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVisibleById indicates an expected call of GetVisibleById.
+func (mr *MockRepositoryMockRecorder) GetVisibleById(schoolId, userId, id, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibleById", reflect.TypeOf((*MockRepository)(nil).GetVisibleById), schoolId, userId, id, now)
+}
+
+// SaveReceipt mocks base method.
+func (m *MockRepository) SaveReceipt(receipt *models.InformationsReceipt) (*models.InformationsReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReceipt", receipt)
+	ret0, _ := ret[0].(*models.InformationsReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveReceipt indicates an expected call of SaveReceipt.
+func (mr *MockRepositoryMockRecorder) SaveReceipt(receipt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReceipt", reflect.TypeOf((*MockRepository)(nil).SaveReceipt), receipt)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(informations *models.Informations, revision *models.InformationsRevision) (*models.Informations, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetBySchoolIdAndId", schoolId, informationId)
+	ret := m.ctrl.Call(m, "Update", informations, revision)
 	ret0, _ := ret[0].(*models.Informations)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetBySchoolIdAndId indicates an expected call of GetBySchoolIdAndId.
-func (mr *MockRepositoryMockRecorder) GetBySchoolIdAndId(schoolId, informationId any) *gomock.Call {
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(informations, revision any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySchoolIdAndId", reflect.TypeOf((*MockRepository)(nil).GetBySchoolIdAndId), schoolId, informationId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), informations, revision)
 }
@@ -39,6 +39,21 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 	return m.recorder
 }
 
+// Acknowledge mocks base method.
+func (m *MockUseCase) Acknowledge(user *models.User, id uint) (*models.InformationsReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Acknowledge", user, id)
+	ret0, _ := ret[0].(*models.InformationsReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Acknowledge indicates an expected call of Acknowledge.
+func (mr *MockUseCaseMockRecorder) Acknowledge(user, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Acknowledge", reflect.TypeOf((*MockUseCase)(nil).Acknowledge), user, id)
+}
+
 // Create mocks base method.
 func (m *MockUseCase) Create(user *models.User, informations *models.Informations) (*models.Informations, error) {
 	m.ctrl.T.Helper()
@@ -97,3 +112,91 @@ func (mr *MockUseCaseMockRecorder) GetById(user, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockUseCase)(nil).GetById), user, id)
 }
+
+// GetReceipts mocks base method.
+func (m *MockUseCase) GetReceipts(user *models.User, id uint) (*models.InformationsReceipts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceipts", user, id)
+	ret0, _ := ret[0].(*models.InformationsReceipts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceipts indicates an expected call of GetReceipts.
+func (mr *MockUseCaseMockRecorder) GetReceipts(user, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceipts", reflect.TypeOf((*MockUseCase)(nil).GetReceipts), user, id)
+}
+
+// GetRevisions mocks base method.
+func (m *MockUseCase) GetRevisions(user *models.User, id uint) (*models.InformationsHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", user, id)
+	ret0, _ := ret[0].(*models.InformationsHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockUseCaseMockRecorder) GetRevisions(user, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockUseCase)(nil).GetRevisions), user, id)
+}
+
+// MarkRead mocks base method.
+func (m *MockUseCase) MarkRead(user *models.User, id uint) (*models.InformationsReceipt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", user, id)
+	ret0, _ := ret[0].(*models.InformationsReceipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockUseCaseMockRecorder) MarkRead(user, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockUseCase)(nil).MarkRead), user, id)
+}
+
+// NotifyPublished mocks base method.
+func (m *MockUseCase) NotifyPublished() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyPublished")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyPublished indicates an expected call of NotifyPublished.
+func (mr *MockUseCaseMockRecorder) NotifyPublished() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyPublished", reflect.TypeOf((*MockUseCase)(nil).NotifyPublished))
+}
+
+// SendReminder mocks base method.
+func (m *MockUseCase) SendReminder(user *models.User, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendReminder", user, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendReminder indicates an expected call of SendReminder.
+func (mr *MockUseCaseMockRecorder) SendReminder(user, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendReminder", reflect.TypeOf((*MockUseCase)(nil).SendReminder), user, id)
+}
+
+// Update mocks base method.
+func (m *MockUseCase) Update(user *models.User, id uint, updatedInformations *models.Informations) (*models.Informations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", user, id, updatedInformations)
+	ret0, _ := ret[0].(*models.Informations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUseCaseMockRecorder) Update(user, id, updatedInformations any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUseCase)(nil).Update), user, id, updatedInformations)
+}
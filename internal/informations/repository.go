@@ -1,6 +1,8 @@
 package informations
 
 import (
+	"time"
+
 	"github.com/esgi-challenge/backend/internal/models"
 )
 
@@ -9,6 +11,21 @@ type Repository interface {
 	GetAll() (*[]models.Informations, error)
 	GetById(id uint) (*models.Informations, error)
 	GetBySchoolId(schoolId uint) (*[]models.Informations, error)
-	GetBySchoolIdAndId(schoolId uint, informationId uint) (*models.Informations, error)
+	// Published informations targeting the user, with the receipt of the user
+	GetVisible(schoolId uint, userId uint, now time.Time) (*[]models.Informations, error)
+	GetVisibleById(schoolId uint, userId uint, id uint, now time.Time) (*models.Informations, error)
+	// Classes, paths or campuses of the school among the ids
+	CountSchoolTargets(schoolId uint, kind models.InformationsTargetKind, ids []uint) (int64, error)
+	GetRecipientIds(informations *models.Informations) ([]uint, error)
+	GetPendingNotification(now time.Time) (*[]models.Informations, error)
+	// False when another instance already notified the audience
+	ClaimNotification(id uint) (bool, error)
+	// The revision is nil when the title and description did not change
+	Update(informations *models.Informations, revision *models.InformationsRevision) (*models.Informations, error)
+	GetRevisions(informationsId uint) (*[]models.InformationsRevision, error)
 	Delete(id uint) error
+	SaveReceipt(receipt *models.InformationsReceipt) (*models.InformationsReceipt, error)
+	// Users of the current audience who read and who acknowledged
+	CountReceipts(informations *models.Informations) (int64, int64, error)
+	GetPendingUsers(informations *models.Informations, read bool) ([]models.InformationsPendingUser, error)
 }
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// The first read and acknowledgement dates are kept
+func (r *informationsRepo) SaveReceipt(receipt *models.InformationsReceipt) (*models.InformationsReceipt, error) {
+	err := r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "informations_id"}, {Name: "user_id"}},
+		DoUpdates: clause.Set{{
+			Column: clause.Column{Name: "acknowledged_at"},
+			Value:  gorm.Expr("COALESCE(informations_receipts.acknowledged_at, excluded.acknowledged_at)"),
+		}},
+	}).Create(receipt).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	var saved models.InformationsReceipt
+
+	if err := r.db.First(&saved, "informations_id = ? AND user_id = ?", receipt.InformationsId, receipt.UserId).Error; err != nil {
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+// Only the receipts of the current audience are counted, users can leave it after reading
+func (r *informationsRepo) CountReceipts(informations *models.Informations) (int64, int64, error) {
+	var counts struct {
+		Read         int64
+		Acknowledged int64
+	}
+
+	err := r.db.Model(&models.InformationsReceipt{}).
+		Select("count(*) AS read, count(informations_receipts.acknowledged_at) AS acknowledged").
+		Joins("JOIN users ON users.id = informations_receipts.user_id AND users.deleted_at IS NULL").
+		Where("informations_receipts.informations_id = @informations AND "+recipientsCondition, recipientsArgs(informations)...).
+		Scan(&counts).Error
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return counts.Read, counts.Acknowledged, nil
+}
+
+func (r *informationsRepo) GetPendingUsers(informations *models.Informations, read bool) ([]models.InformationsPendingUser, error) {
+	users := []models.InformationsPendingUser{}
+
+	if err := r.db.Raw(pendingUsersQuery, append(recipientsArgs(informations), sql.Named("read", read))...).Scan(&users).Error; err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
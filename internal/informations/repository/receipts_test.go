@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func setupMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	dialector := postgres.New(postgres.Config{
+		Conn:       db,
+		DriverName: "postgres",
+	})
+	gormDB, err := gorm.Open(dialector, &gorm.Config{})
+	assert.NoError(t, err)
+
+	return gormDB, mock
+}
+
+func TestCountReceipts(t *testing.T) {
+	t.Parallel()
+
+	db, mock := setupMockDB(t)
+	repo := NewInformationsRepository(db)
+
+	t.Run("only the students and teachers of the audience", func(t *testing.T) {
+		mock.ExpectQuery(regexp.QuoteMeta("users.user_kind IN ($3, $4)")).
+			WithArgs(5, 3, models.STUDENT, models.TEACHER, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"read", "acknowledged"}).AddRow(3, 2))
+
+		read, acknowledged, err := repo.CountReceipts(&models.Informations{GormModel: models.GormModel{ID: 5}, SchoolId: 3})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), read)
+		assert.Equal(t, int64(2), acknowledged)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"database/sql"
+	"time"
+
 	"github.com/esgi-challenge/backend/internal/informations"
 	"github.com/esgi-challenge/backend/internal/models"
 	"gorm.io/gorm"
@@ -14,6 +17,11 @@ func NewInformationsRepository(db *gorm.DB) informations.Repository {
 	return &informationsRepo{db: db}
 }
 
+// Pinned informations first, then the newest
+func (r *informationsRepo) withRelations() *gorm.DB {
+	return r.db.Preload("Targets").Preload("Document").Order("pinned DESC, publish_at DESC, id DESC")
+}
+
 func (r *informationsRepo) Create(informations *models.Informations) (*models.Informations, error) {
 	if err := r.db.Create(informations).Error; err != nil {
 		return nil, err
@@ -35,17 +43,39 @@ func (r *informationsRepo) GetAll() (*[]models.Informations, error) {
 func (r *informationsRepo) GetBySchoolId(schoolId uint) (*[]models.Informations, error) {
 	var informations []models.Informations
 
-	if err := r.db.Find(&informations, "school_id = ?", schoolId).Error; err != nil {
+	if err := r.withRelations().Find(&informations, "school_id = ?", schoolId).Error; err != nil {
+		return nil, err
+	}
+
+	return &informations, nil
+}
+
+func (r *informationsRepo) GetVisible(schoolId uint, userId uint, now time.Time) (*[]models.Informations, error) {
+	var informations []models.Informations
+
+	err := r.withRelations().Preload("Receipt", "user_id = ?", userId).Where(visibleCondition,
+		sql.Named("school", schoolId),
+		sql.Named("user", userId),
+		sql.Named("now", now),
+	).Find(&informations).Error
+
+	if err != nil {
 		return nil, err
 	}
 
 	return &informations, nil
 }
 
-func (r *informationsRepo) GetBySchoolIdAndId(schoolId uint, informationId uint) (*models.Informations, error) {
+func (r *informationsRepo) GetVisibleById(schoolId uint, userId uint, id uint, now time.Time) (*models.Informations, error) {
 	var informations models.Informations
 
-	if err := r.db.Find(&informations, "school_id = ? AND id = ", schoolId, informationId).Error; err != nil {
+	err := r.withRelations().Preload("Receipt", "user_id = ?", userId).Where(visibleCondition,
+		sql.Named("school", schoolId),
+		sql.Named("user", userId),
+		sql.Named("now", now),
+	).First(&informations, id).Error
+
+	if err != nil {
 		return nil, err
 	}
 
@@ -55,13 +85,84 @@ func (r *informationsRepo) GetBySchoolIdAndId(schoolId uint, informationId uint)
 func (r *informationsRepo) GetById(id uint) (*models.Informations, error) {
 	var informations models.Informations
 
-	if err := r.db.First(&informations, id).Error; err != nil {
+	if err := r.withRelations().First(&informations, id).Error; err != nil {
 		return nil, err
 	}
 
 	return &informations, nil
 }
 
+func (r *informationsRepo) CountSchoolTargets(schoolId uint, kind models.InformationsTargetKind, ids []uint) (int64, error) {
+	var count int64
+	var model any
+
+	switch kind {
+	case models.INFORMATIONS_TARGET_CLASS:
+		model = &models.Class{}
+	case models.INFORMATIONS_TARGET_PATH:
+		model = &models.Path{}
+	case models.INFORMATIONS_TARGET_CAMPUS:
+		model = &models.Campus{}
+	default:
+		return 0, nil
+	}
+
+	if err := r.db.Model(model).Where("school_id = ? AND id IN ?", schoolId, ids).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Named arguments of recipientsCondition
+func recipientsArgs(informations *models.Informations) []any {
+	return []any{
+		sql.Named("school", informations.SchoolId),
+		sql.Named("informations", informations.ID),
+		sql.Named("student", models.STUDENT),
+		sql.Named("teacher", models.TEACHER),
+	}
+}
+
+func (r *informationsRepo) GetRecipientIds(informations *models.Informations) ([]uint, error) {
+	var ids []uint
+
+	err := r.db.Model(&models.User{}).Where(recipientsCondition, recipientsArgs(informations)...).Pluck("id", &ids).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *informationsRepo) GetPendingNotification(now time.Time) (*[]models.Informations, error) {
+	var informations []models.Informations
+
+	err := r.db.
+		Where("notification_pending AND publish_at <= ?", now).
+		Where("expires_at IS NULL OR expires_at > ?", now).
+		Find(&informations).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &informations, nil
+}
+
+func (r *informationsRepo) ClaimNotification(id uint) (bool, error) {
+	result := r.db.Model(&models.Informations{}).
+		Where("id = ? AND notification_pending", id).
+		Update("notification_pending", false)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
 func (r *informationsRepo) Delete(id uint) error {
 	if err := r.db.Debug().Delete(&models.Informations{}, id).Error; err != nil {
 		return err
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"github.com/esgi-challenge/backend/internal/models"
+	"gorm.io/gorm"
+)
+
+func (r *informationsRepo) Update(informations *models.Informations, revision *models.InformationsRevision) (*models.Informations, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if revision != nil {
+			if err := tx.Create(revision).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(informations).
+			Select("title", "description", "edited_at", "expires_at", "pinned", "document_id", "requires_ack").
+			Updates(informations).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return informations, nil
+}
+
+func (r *informationsRepo) GetRevisions(informationsId uint) (*[]models.InformationsRevision, error) {
+	revisions := []models.InformationsRevision{}
+
+	if err := r.db.Where("informations_id = ?", informationsId).Order("id").Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+
+	return &revisions, nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+const (
+	// Whether the row of the outer "users" can see the row of the outer "informations".
+	// Students belong to their class, its path and the campuses it has courses on, teachers
+	// to the classes, paths and campuses of the courses they teach
+	audienceCondition = `
+	NOT EXISTS (
+		SELECT 1
+		FROM informations_targets AS target
+		WHERE
+			target.informations_id = informations.id
+			AND NOT EXISTS (
+				SELECT 1
+				FROM informations_targets AS matched
+				WHERE
+					matched.informations_id = informations.id
+					AND matched.kind = target.kind
+					AND (
+						(matched.kind = 'userKind' AND matched.target_id = users.user_kind)
+						OR (matched.kind = 'class' AND matched.target_id IN (
+							SELECT users.class_refer
+							UNION
+							SELECT schedules.class
+							FROM schedules
+							JOIN courses ON courses.id = schedules.course AND courses.deleted_at IS NULL
+							WHERE schedules.deleted_at IS NULL AND courses.teacher_id = users.id
+						))
+						OR (matched.kind = 'path' AND matched.target_id IN (
+							SELECT classes.path_id
+							FROM classes
+							WHERE classes.deleted_at IS NULL AND classes.id = users.class_refer
+							UNION
+							SELECT courses."pathId"
+							FROM courses
+							WHERE courses.deleted_at IS NULL AND courses.teacher_id = users.id
+						))
+						OR (matched.kind = 'campus' AND matched.target_id IN (
+							SELECT schedules.campus
+							FROM schedules
+							LEFT JOIN courses ON courses.id = schedules.course AND courses.deleted_at IS NULL
+							WHERE
+								schedules.deleted_at IS NULL
+								AND (schedules.class = users.class_refer OR courses.teacher_id = users.id)
+						))
+					)
+			)
+	)
+	`
+
+	// Published and not expired informations of the school the user can see
+	visibleCondition = `
+	informations.school_id = @school
+	AND informations.publish_at <= @now
+	AND (informations.expires_at IS NULL OR informations.expires_at > @now)
+	AND EXISTS (
+		SELECT 1
+		FROM users
+		WHERE users.id = @user AND ` + audienceCondition + `
+	)
+	`
+
+	// Students and teachers of the school in the audience, the administrators manage the
+	// informations and don't get them
+	recipientsCondition = `
+	users.school_id = @school
+	AND users.user_kind IN (@student, @teacher)
+	AND EXISTS (
+		SELECT 1
+		FROM informations
+		WHERE informations.id = @informations AND ` + audienceCondition + `
+	)
+	`
+
+	// Audience of the informations without an acknowledgement, or without a read when
+	// @read is set
+	pendingUsersQuery = `
+	SELECT
+		users.*,
+		informations_receipts.read_at
+	FROM
+		users
+		LEFT JOIN informations_receipts ON informations_receipts.informations_id = @informations AND informations_receipts.user_id = users.id
+	WHERE
+		users.deleted_at IS NULL
+		AND (
+			(@read AND informations_receipts.read_at IS NULL)
+			OR (NOT @read AND informations_receipts.acknowledged_at IS NULL)
+		)
+		AND ` + recipientsCondition + `
+	ORDER BY users.lastname, users.firstname, users.id
+	`
+)
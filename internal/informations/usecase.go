@@ -6,7 +6,19 @@ import (
 
 type UseCase interface {
 	Create(user *models.User, informations *models.Informations) (*models.Informations, error)
+	// Every informations of the school for its administrator, the published ones targeting
+	// the user for the others
 	GetAll(user *models.User) (*[]models.Informations, error)
 	GetById(user *models.User, id uint) (*models.Informations, error)
+	// The description is Markdown, a revision keeps the previous title and description when they change
+	Update(user *models.User, id uint, updatedInformations *models.Informations) (*models.Informations, error)
+	GetRevisions(user *models.User, id uint) (*models.InformationsHistory, error)
 	Delete(user *models.User, id uint) error
+	MarkRead(user *models.User, id uint) (*models.InformationsReceipt, error)
+	Acknowledge(user *models.User, id uint) (*models.InformationsReceipt, error)
+	// Read and acknowledgement counts with the users still pending, for the administrator
+	GetReceipts(user *models.User, id uint) (*models.InformationsReceipts, error)
+	SendReminder(user *models.User, id uint) error
+	// Notify the audience of the scheduled informations published since the last run
+	NotifyPublished() error
 }
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+)
+
+func (u *informationsUseCase) MarkRead(user *models.User, id uint) (*models.InformationsReceipt, error) {
+	informations, err := u.GetById(user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.informationsRepo.SaveReceipt(&models.InformationsReceipt{
+		InformationsId: informations.ID,
+		UserId:         user.ID,
+		ReadAt:         time.Now(),
+	})
+}
+
+// Acknowledging also reads the informations
+func (u *informationsUseCase) Acknowledge(user *models.User, id uint) (*models.InformationsReceipt, error) {
+	informations, err := u.GetById(user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !informations.RequiresAck {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "This information does not require an acknowledgement",
+		}
+	}
+
+	now := time.Now()
+
+	return u.informationsRepo.SaveReceipt(&models.InformationsReceipt{
+		InformationsId: informations.ID,
+		UserId:         user.ID,
+		ReadAt:         now,
+		AcknowledgedAt: &now,
+	})
+}
+
+// Informations of the school of the administrator
+func (u *informationsUseCase) getOwned(user *models.User, id uint) (*models.Informations, error) {
+	informations, err := u.informationsRepo.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+
+	school, err := u.schoolRepo.GetById(informations.SchoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	if school.UserID != user.ID {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This information is not yours",
+		}
+	}
+
+	return informations, nil
+}
+
+func (u *informationsUseCase) GetReceipts(user *models.User, id uint) (*models.InformationsReceipts, error) {
+	informations, err := u.getOwned(user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	audience, err := u.informationsRepo.GetRecipientIds(informations)
+	if err != nil {
+		return nil, err
+	}
+
+	read, acknowledged, err := u.informationsRepo.CountReceipts(informations)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, err := u.informationsRepo.GetPendingUsers(informations, !informations.RequiresAck)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.InformationsReceipts{
+		Audience:     int64(len(audience)),
+		Read:         read,
+		Acknowledged: acknowledged,
+		Pending:      pending,
+	}, nil
+}
+
+// Notify again the users who did not acknowledge, or did not read when no acknowledgement
+// is required
+func (u *informationsUseCase) SendReminder(user *models.User, id uint) error {
+	informations, err := u.getOwned(user, id)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	if informations.PublishAt.After(now) || (informations.ExpiresAt != nil && !informations.ExpiresAt.After(now)) {
+		return errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "This information is not published",
+		}
+	}
+
+	pending, err := u.informationsRepo.GetPendingUsers(informations, !informations.RequiresAck)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]uint, 0, len(pending))
+	for _, pendingUser := range pending {
+		ids = append(ids, pendingUser.ID)
+	}
+
+	return u.notificationUseCase.Notify(ids, &models.Notification{
+		Type:          models.NOTIFICATION_INFORMATIONS,
+		MessageKey:    models.NOTIFICATION_MESSAGE_INFORMATIONS_REMINDER,
+		MessageParams: informationsParams(informations),
+		RefId:         &informations.ID,
+	})
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/informations/mock"
+	"github.com/esgi-challenge/backend/internal/models"
+	notificationMock "github.com/esgi-challenge/backend/internal/notification/mock"
+	schoolMock "github.com/esgi-challenge/backend/internal/school/mock"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAcknowledge(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInformationsRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewInformationsUseCase(nil, mockInformationsRepo, mockSchoolRepo, nil, nil, logger)
+
+	student := &models.User{GormModel: models.GormModel{ID: 7}, UserKind: models.NewUserKind(models.STUDENT)}
+	school := &models.School{GormModel: models.GormModel{ID: 3}}
+
+	t.Run("acknowledging also reads", func(t *testing.T) {
+		informations := &models.Informations{GormModel: models.GormModel{ID: 5}, RequiresAck: true}
+
+		mockSchoolRepo.EXPECT().GetByUser(student).Return(school, nil)
+		mockInformationsRepo.EXPECT().GetVisibleById(uint(3), student.ID, uint(5), gomock.Any()).Return(informations, nil)
+		mockInformationsRepo.EXPECT().SaveReceipt(gomock.Any()).DoAndReturn(func(receipt *models.InformationsReceipt) (*models.InformationsReceipt, error) {
+			return receipt, nil
+		})
+
+		receipt, err := useCase.Acknowledge(student, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, student.ID, receipt.UserId)
+		assert.False(t, receipt.ReadAt.IsZero())
+		assert.NotNil(t, receipt.AcknowledgedAt)
+	})
+
+	t.Run("acknowledgement not required", func(t *testing.T) {
+		informations := &models.Informations{GormModel: models.GormModel{ID: 5}}
+
+		mockSchoolRepo.EXPECT().GetByUser(student).Return(school, nil)
+		mockInformationsRepo.EXPECT().GetVisibleById(uint(3), student.ID, uint(5), gomock.Any()).Return(informations, nil)
+
+		receipt, err := useCase.Acknowledge(student, 5)
+		assert.Nil(t, receipt)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "This information does not require an acknowledgement",
+		}, err)
+	})
+
+	t.Run("read only", func(t *testing.T) {
+		informations := &models.Informations{GormModel: models.GormModel{ID: 5}, RequiresAck: true}
+
+		mockSchoolRepo.EXPECT().GetByUser(student).Return(school, nil)
+		mockInformationsRepo.EXPECT().GetVisibleById(uint(3), student.ID, uint(5), gomock.Any()).Return(informations, nil)
+		mockInformationsRepo.EXPECT().SaveReceipt(gomock.Any()).DoAndReturn(func(receipt *models.InformationsReceipt) (*models.InformationsReceipt, error) {
+			return receipt, nil
+		})
+
+		receipt, err := useCase.MarkRead(student, 5)
+		assert.NoError(t, err)
+		assert.Nil(t, receipt.AcknowledgedAt)
+	})
+}
+
+func TestGetReceipts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInformationsRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewInformationsUseCase(nil, mockInformationsRepo, mockSchoolRepo, nil, nil, logger)
+
+	admin := &models.User{GormModel: models.GormModel{ID: 1}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+
+	t.Run("pending users have not acknowledged", func(t *testing.T) {
+		informations := &models.Informations{GormModel: models.GormModel{ID: 5}, SchoolId: 3, RequiresAck: true}
+		pending := []models.InformationsPendingUser{{User: models.User{GormModel: models.GormModel{ID: 9}}}}
+
+		mockInformationsRepo.EXPECT().GetById(uint(5)).Return(informations, nil)
+		mockSchoolRepo.EXPECT().GetById(uint(3)).Return(&models.School{GormModel: models.GormModel{ID: 3}, UserID: 1}, nil)
+		mockInformationsRepo.EXPECT().GetRecipientIds(informations).Return([]uint{7, 8, 9}, nil)
+		mockInformationsRepo.EXPECT().CountReceipts(informations).Return(int64(3), int64(2), nil)
+		mockInformationsRepo.EXPECT().GetPendingUsers(informations, false).Return(pending, nil)
+
+		receipts, err := useCase.GetReceipts(admin, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, &models.InformationsReceipts{Audience: 3, Read: 3, Acknowledged: 2, Pending: pending}, receipts)
+	})
+
+	t.Run("informations of another school", func(t *testing.T) {
+		mockInformationsRepo.EXPECT().GetById(uint(5)).Return(&models.Informations{GormModel: models.GormModel{ID: 5}, SchoolId: 4}, nil)
+		mockSchoolRepo.EXPECT().GetById(uint(4)).Return(&models.School{GormModel: models.GormModel{ID: 4}, UserID: 2}, nil)
+
+		receipts, err := useCase.GetReceipts(admin, 5)
+		assert.Nil(t, receipts)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This information is not yours",
+		}, err)
+	})
+}
+
+func TestSendReminder(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInformationsRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	mockNotificationUseCase := notificationMock.NewMockUseCase(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewInformationsUseCase(nil, mockInformationsRepo, mockSchoolRepo, nil, mockNotificationUseCase, logger)
+
+	admin := &models.User{GormModel: models.GormModel{ID: 1}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+	school := &models.School{GormModel: models.GormModel{ID: 3}, UserID: 1}
+
+	t.Run("pending readers are notified", func(t *testing.T) {
+		informations := &models.Informations{GormModel: models.GormModel{ID: 5}, Title: "Exam rules", SchoolId: 3, PublishAt: time.Now().Add(-time.Hour)}
+		pending := []models.InformationsPendingUser{
+			{User: models.User{GormModel: models.GormModel{ID: 8}}},
+			{User: models.User{GormModel: models.GormModel{ID: 9}}},
+		}
+
+		mockInformationsRepo.EXPECT().GetById(uint(5)).Return(informations, nil)
+		mockSchoolRepo.EXPECT().GetById(uint(3)).Return(school, nil)
+		mockInformationsRepo.EXPECT().GetPendingUsers(informations, true).Return(pending, nil)
+		mockNotificationUseCase.EXPECT().Notify([]uint{8, 9}, gomock.Any()).DoAndReturn(func(userIds []uint, notification *models.Notification) error {
+			assert.Equal(t, models.NOTIFICATION_MESSAGE_INFORMATIONS_REMINDER, notification.MessageKey)
+			assert.Equal(t, "Exam rules", notification.MessageParams["title"])
+			assert.Equal(t, &informations.ID, notification.RefId)
+			return nil
+		})
+
+		assert.NoError(t, useCase.SendReminder(admin, 5))
+	})
+
+	expiredAt := time.Now().Add(-time.Minute)
+
+	for name, informations := range map[string]*models.Informations{
+		"not published yet": {GormModel: models.GormModel{ID: 5}, SchoolId: 3, PublishAt: time.Now().Add(time.Hour)},
+		"expired":           {GormModel: models.GormModel{ID: 5}, SchoolId: 3, PublishAt: time.Now().Add(-time.Hour), ExpiresAt: &expiredAt},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockInformationsRepo.EXPECT().GetById(uint(5)).Return(informations, nil)
+			mockSchoolRepo.EXPECT().GetById(uint(3)).Return(school, nil)
+
+			assert.Equal(t, errorHandler.HttpError{
+				HttpStatus: http.StatusBadRequest,
+				HttpError:  "This information is not published",
+			}, useCase.SendReminder(admin, 5))
+		})
+	}
+}
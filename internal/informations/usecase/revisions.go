@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/markdown"
+)
+
+func renderInformations(informations *models.Informations) error {
+	html, err := markdown.ToSafeHtml(informations.Description)
+	if err != nil {
+		return err
+	}
+
+	informations.DescriptionHtml = html
+
+	return nil
+}
+
+func renderInformationsList(informations *[]models.Informations) (*[]models.Informations, error) {
+	for i := range *informations {
+		if err := renderInformations(&(*informations)[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return informations, nil
+}
+
+// A revision keeps the previous title and description when one of them changes
+func (u *informationsUseCase) Update(user *models.User, id uint, updatedInformations *models.Informations) (*models.Informations, error) {
+	informations, err := u.getOwned(user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if updatedInformations.ExpiresAt != nil && !updatedInformations.ExpiresAt.After(informations.PublishAt) {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The informations must expire after being published",
+		}
+	}
+
+	if updatedInformations.DocumentId != nil {
+		_, err = u.documentUseCase.GetById(user, *updatedInformations.DocumentId)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var revision *models.InformationsRevision
+
+	if informations.Title != updatedInformations.Title || informations.Description != updatedInformations.Description {
+		revision = &models.InformationsRevision{
+			InformationsId: informations.ID,
+			Title:          informations.Title,
+			Description:    informations.Description,
+			EditorId:       user.ID,
+		}
+
+		now := time.Now()
+		informations.EditedAt = &now
+	}
+
+	informations.Title = updatedInformations.Title
+	informations.Description = updatedInformations.Description
+	informations.ExpiresAt = updatedInformations.ExpiresAt
+	informations.Pinned = updatedInformations.Pinned
+	informations.DocumentId = updatedInformations.DocumentId
+	informations.RequiresAck = updatedInformations.RequiresAck
+
+	if _, err := u.informationsRepo.Update(informations, revision); err != nil {
+		return nil, err
+	}
+
+	// Reloaded for the new document
+	informations, err = u.informationsRepo.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := renderInformations(informations); err != nil {
+		return nil, err
+	}
+
+	return informations, nil
+}
+
+// Oldest revision first, the current version is the informations
+func (u *informationsUseCase) GetRevisions(user *models.User, id uint) (*models.InformationsHistory, error) {
+	informations, err := u.GetById(user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := u.informationsRepo.GetRevisions(informations.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range *revisions {
+		revision := &(*revisions)[i]
+
+		html, err := markdown.ToSafeHtml(revision.Description)
+		if err != nil {
+			return nil, err
+		}
+
+		revision.DescriptionHtml = html
+	}
+
+	return &models.InformationsHistory{
+		Informations: *informations,
+		Revisions:    *revisions,
+	}, nil
+}
@@ -2,28 +2,36 @@ package usecase
 
 import (
 	"net/http"
+	"slices"
+	"time"
 
 	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/document"
 	"github.com/esgi-challenge/backend/internal/informations"
 	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/notification"
 	"github.com/esgi-challenge/backend/internal/school"
 	"github.com/esgi-challenge/backend/pkg/errorHandler"
 	"github.com/esgi-challenge/backend/pkg/logger"
 )
 
 type informationsUseCase struct {
-	informationsRepo informations.Repository
-	schoolRepo       school.Repository
-	cfg              *config.Config
-	logger           logger.Logger
+	informationsRepo    informations.Repository
+	schoolRepo          school.Repository
+	documentUseCase     document.UseCase
+	notificationUseCase notification.UseCase
+	cfg                 *config.Config
+	logger              logger.Logger
 }
 
-func NewInformationsUseCase(cfg *config.Config, informationsRepo informations.Repository, schoolRepo school.Repository, logger logger.Logger) informations.UseCase {
+func NewInformationsUseCase(cfg *config.Config, informationsRepo informations.Repository, schoolRepo school.Repository, documentUseCase document.UseCase, notificationUseCase notification.UseCase, logger logger.Logger) informations.UseCase {
 	return &informationsUseCase{
-		cfg:              cfg,
-		informationsRepo: informationsRepo,
-		schoolRepo:       schoolRepo,
-		logger:           logger,
+		cfg:                 cfg,
+		informationsRepo:    informationsRepo,
+		schoolRepo:          schoolRepo,
+		documentUseCase:     documentUseCase,
+		notificationUseCase: notificationUseCase,
+		logger:              logger,
 	}
 }
 
@@ -41,51 +49,202 @@ func (u *informationsUseCase) Create(user *models.User, informations *models.Inf
 		}
 	}
 
-	return u.informationsRepo.Create(informations)
-}
+	now := time.Now()
 
-func (u *informationsUseCase) GetAll(user *models.User) (*[]models.Informations, error) {
-	if *user.UserKind == models.STUDENT {
-		return u.informationsRepo.GetBySchoolId(*user.SchoolId)
+	if informations.PublishAt.IsZero() {
+		informations.PublishAt = now
 	}
 
-	school, err := u.schoolRepo.GetByUser(user)
+	if informations.ExpiresAt != nil && !informations.ExpiresAt.After(informations.PublishAt) {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The informations must expire after being published",
+		}
+	}
+
+	if err := u.checkTargets(informations); err != nil {
+		return nil, err
+	}
+
+	if informations.DocumentId != nil {
+		_, err = u.documentUseCase.GetById(user, *informations.DocumentId)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Scheduled informations are notified once published
+	informations.NotificationPending = informations.PublishAt.After(now)
+
+	informations, err = u.informationsRepo.Create(informations)
 	if err != nil {
 		return nil, err
 	}
 
-	return u.informationsRepo.GetBySchoolId(school.ID)
+	if !informations.NotificationPending {
+		u.notify(informations)
+	}
+
+	if err := renderInformations(informations); err != nil {
+		return nil, err
+	}
+
+	return informations, nil
 }
 
-func (u *informationsUseCase) GetById(user *models.User, id uint) (*models.Informations, error) {
-	if *user.UserKind == models.STUDENT {
-		return u.informationsRepo.GetBySchoolIdAndId(*user.SchoolId, id)
+// Classes, paths and campuses must be of the school of the informations
+func (u *informationsUseCase) checkTargets(informations *models.Informations) error {
+	ids := map[models.InformationsTargetKind][]uint{}
+
+	for _, target := range informations.Targets {
+		ids[target.Kind] = append(ids[target.Kind], target.TargetId)
 	}
 
-	return u.informationsRepo.GetById(id)
+	for kind, targetIds := range ids {
+		slices.Sort(targetIds)
+		targetIds = slices.Compact(targetIds)
+
+		if kind == models.INFORMATIONS_TARGET_USER_KIND {
+			for _, id := range targetIds {
+				if id != models.STUDENT && id != models.TEACHER {
+					return errorHandler.HttpError{
+						HttpStatus: http.StatusBadRequest,
+						HttpError:  "Only students and teachers can be targeted",
+					}
+				}
+			}
+
+			continue
+		}
+
+		count, err := u.informationsRepo.CountSchoolTargets(informations.SchoolId, kind, targetIds)
+		if err != nil {
+			return err
+		}
+
+		if count != int64(len(targetIds)) {
+			return errorHandler.HttpError{
+				HttpStatus: http.StatusBadRequest,
+				HttpError:  "A targeted " + string(kind) + " is not from your school",
+			}
+		}
+	}
+
+	return nil
 }
 
-func (u *informationsUseCase) Delete(user *models.User, id uint) error {
-	// Check not needed but added to handle a not found error because gorm do not return
-	// error if delete on a row that does not exist
-	dbInformation, err := u.GetById(user, id)
+func informationsParams(informations *models.Informations) map[string]string {
+	return map[string]string{
+		"title":       informations.Title,
+		"description": informations.Description,
+	}
+}
 
+// The informations are published even if the audience could not be notified
+func (u *informationsUseCase) notify(informations *models.Informations) {
+	ids, err := u.informationsRepo.GetRecipientIds(informations)
 	if err != nil {
-		return err
+		u.logger.Errorf("Notification: informations %d: %v", informations.ID, err)
+		return
 	}
 
-	school, err := u.schoolRepo.GetById(dbInformation.SchoolId)
+	err = u.notificationUseCase.Notify(ids, &models.Notification{
+		Type:          models.NOTIFICATION_INFORMATIONS,
+		MessageKey:    models.NOTIFICATION_MESSAGE_INFORMATIONS,
+		MessageParams: informationsParams(informations),
+		RefId:         &informations.ID,
+	})
+	if err != nil {
+		u.logger.Errorf("Notification: informations %d: %v", informations.ID, err)
+	}
+}
 
+func (u *informationsUseCase) NotifyPublished() error {
+	pending, err := u.informationsRepo.GetPendingNotification(time.Now())
 	if err != nil {
 		return err
 	}
 
-	if school.UserID != user.ID {
-		return errorHandler.HttpError{
-			HttpStatus: http.StatusForbidden,
-			HttpError:  "This information is not yours",
+	for i := range *pending {
+		informations := &(*pending)[i]
+
+		claimed, err := u.informationsRepo.ClaimNotification(informations.ID)
+		if err != nil {
+			return err
+		}
+
+		if claimed {
+			u.notify(informations)
 		}
 	}
 
+	return nil
+}
+
+func (u *informationsUseCase) GetAll(user *models.User) (*[]models.Informations, error) {
+	school, err := u.schoolRepo.GetByUser(user)
+	if err != nil {
+		return nil, err
+	}
+
+	var informations *[]models.Informations
+
+	if school.UserID == user.ID {
+		informations, err = u.informationsRepo.GetBySchoolId(school.ID)
+	} else {
+		informations, err = u.informationsRepo.GetVisible(school.ID, user.ID, time.Now())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return renderInformationsList(informations)
+}
+
+func (u *informationsUseCase) GetById(user *models.User, id uint) (*models.Informations, error) {
+	school, err := u.schoolRepo.GetByUser(user)
+	if err != nil {
+		return nil, err
+	}
+
+	var informations *models.Informations
+
+	if school.UserID == user.ID {
+		informations, err = u.informationsRepo.GetById(id)
+		if err != nil {
+			return nil, err
+		}
+
+		if informations.SchoolId != school.ID {
+			return nil, errorHandler.HttpError{
+				HttpStatus: http.StatusForbidden,
+				HttpError:  "This information is not yours",
+			}
+		}
+	} else {
+		informations, err = u.informationsRepo.GetVisibleById(school.ID, user.ID, id, time.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := renderInformations(informations); err != nil {
+		return nil, err
+	}
+
+	return informations, nil
+}
+
+func (u *informationsUseCase) Delete(user *models.User, id uint) error {
+	// Check not needed but added to handle a not found error because gorm do not return
+	// error if delete on a row that does not exist
+	_, err := u.getOwned(user, id)
+
+	if err != nil {
+		return err
+	}
+
 	return u.informationsRepo.Delete(id)
 }
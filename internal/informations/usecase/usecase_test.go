@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	documentMock "github.com/esgi-challenge/backend/internal/document/mock"
+	"github.com/esgi-challenge/backend/internal/informations/mock"
+	"github.com/esgi-challenge/backend/internal/models"
+	notificationMock "github.com/esgi-challenge/backend/internal/notification/mock"
+	schoolMock "github.com/esgi-challenge/backend/internal/school/mock"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateInformations(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInformationsRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	mockNotificationUseCase := notificationMock.NewMockUseCase(ctrl)
+	logger := logger.NewLogger()
+	logger.InitLogger()
+
+	useCase := NewInformationsUseCase(nil, mockInformationsRepo, mockSchoolRepo, documentMock.NewMockUseCase(ctrl), mockNotificationUseCase, logger)
+
+	user := &models.User{GormModel: models.GormModel{ID: 1}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+	school := &models.School{GormModel: models.GormModel{ID: 3}, UserID: 1}
+
+	t.Run("published now notifies the audience", func(t *testing.T) {
+		informations := &models.Informations{Title: "title", Description: "description", SchoolId: 3}
+
+		mockSchoolRepo.EXPECT().GetById(uint(3)).Return(school, nil)
+		mockInformationsRepo.EXPECT().Create(informations).DoAndReturn(func(informations *models.Informations) (*models.Informations, error) {
+			informations.ID = 5
+			return informations, nil
+		})
+		mockInformationsRepo.EXPECT().GetRecipientIds(informations).Return([]uint{7, 8}, nil)
+		mockNotificationUseCase.EXPECT().Notify([]uint{7, 8}, gomock.Any()).DoAndReturn(func(userIds []uint, notification *models.Notification) error {
+			assert.Equal(t, models.NOTIFICATION_MESSAGE_INFORMATIONS, notification.MessageKey)
+			assert.Equal(t, "title", notification.MessageParams["title"])
+			return nil
+		})
+
+		created, err := useCase.Create(user, informations)
+		assert.NoError(t, err)
+		assert.False(t, created.NotificationPending)
+		assert.False(t, created.PublishAt.IsZero())
+	})
+
+	t.Run("scheduled is notified once published", func(t *testing.T) {
+		informations := &models.Informations{Title: "title", SchoolId: 3, PublishAt: time.Now().Add(time.Hour)}
+
+		mockSchoolRepo.EXPECT().GetById(uint(3)).Return(school, nil)
+		mockInformationsRepo.EXPECT().Create(informations).Return(informations, nil)
+
+		created, err := useCase.Create(user, informations)
+		assert.NoError(t, err)
+		assert.True(t, created.NotificationPending)
+	})
+
+	t.Run("expires before being published", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour)
+		informations := &models.Informations{SchoolId: 3, PublishAt: expiresAt.Add(time.Hour), ExpiresAt: &expiresAt}
+
+		mockSchoolRepo.EXPECT().GetById(uint(3)).Return(school, nil)
+
+		created, err := useCase.Create(user, informations)
+		assert.Nil(t, created)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "The informations must expire after being published",
+		}, err)
+	})
+
+	t.Run("school not owned by user", func(t *testing.T) {
+		other := &models.User{GormModel: models.GormModel{ID: 2}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+
+		mockSchoolRepo.EXPECT().GetById(uint(3)).Return(school, nil)
+
+		created, err := useCase.Create(other, &models.Informations{SchoolId: 3})
+		assert.Nil(t, created)
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This school is not yours",
+		}, err)
+	})
+}
+
+func TestCheckTargets(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInformationsRepo := mock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewInformationsUseCase(nil, mockInformationsRepo, nil, nil, nil, logger).(*informationsUseCase)
+
+	t.Run("duplicated targets are counted once", func(t *testing.T) {
+		informations := &models.Informations{SchoolId: 3, Targets: []models.InformationsTarget{
+			{Kind: models.INFORMATIONS_TARGET_CLASS, TargetId: 4},
+			{Kind: models.INFORMATIONS_TARGET_CLASS, TargetId: 2},
+			{Kind: models.INFORMATIONS_TARGET_CLASS, TargetId: 4},
+			{Kind: models.INFORMATIONS_TARGET_USER_KIND, TargetId: models.TEACHER},
+		}}
+
+		mockInformationsRepo.EXPECT().CountSchoolTargets(uint(3), models.INFORMATIONS_TARGET_CLASS, []uint{2, 4}).Return(int64(2), nil)
+
+		assert.NoError(t, useCase.checkTargets(informations))
+	})
+
+	t.Run("target of another school", func(t *testing.T) {
+		informations := &models.Informations{SchoolId: 3, Targets: []models.InformationsTarget{
+			{Kind: models.INFORMATIONS_TARGET_PATH, TargetId: 2},
+			{Kind: models.INFORMATIONS_TARGET_PATH, TargetId: 3},
+		}}
+
+		mockInformationsRepo.EXPECT().CountSchoolTargets(uint(3), models.INFORMATIONS_TARGET_PATH, []uint{2, 3}).Return(int64(1), nil)
+
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "A targeted path is not from your school",
+		}, useCase.checkTargets(informations))
+	})
+
+	t.Run("administrators can't be targeted", func(t *testing.T) {
+		informations := &models.Informations{SchoolId: 3, Targets: []models.InformationsTarget{
+			{Kind: models.INFORMATIONS_TARGET_USER_KIND, TargetId: models.ADMINISTRATOR},
+		}}
+
+		assert.Equal(t, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "Only students and teachers can be targeted",
+		}, useCase.checkTargets(informations))
+	})
+}
+
+func TestNotifyPublished(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInformationsRepo := mock.NewMockRepository(ctrl)
+	mockNotificationUseCase := notificationMock.NewMockUseCase(ctrl)
+	logger := logger.NewLogger()
+	logger.InitLogger()
+
+	useCase := NewInformationsUseCase(nil, mockInformationsRepo, nil, nil, mockNotificationUseCase, logger)
+
+	pending := &[]models.Informations{{GormModel: models.GormModel{ID: 1}}, {GormModel: models.GormModel{ID: 2}}}
+
+	mockInformationsRepo.EXPECT().GetPendingNotification(gomock.Any()).Return(pending, nil)
+	mockInformationsRepo.EXPECT().ClaimNotification(uint(1)).Return(true, nil)
+	// Already notified by another instance
+	mockInformationsRepo.EXPECT().ClaimNotification(uint(2)).Return(false, nil)
+	mockInformationsRepo.EXPECT().GetRecipientIds(&(*pending)[0]).Return([]uint{7}, nil)
+	mockNotificationUseCase.EXPECT().Notify([]uint{7}, gomock.Any()).Return(nil)
+
+	assert.NoError(t, useCase.NotifyPublished())
+}
+
+func TestGetAllInformations(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockInformationsRepo := mock.NewMockRepository(ctrl)
+	mockSchoolRepo := schoolMock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+
+	useCase := NewInformationsUseCase(nil, mockInformationsRepo, mockSchoolRepo, nil, nil, logger)
+
+	admin := &models.User{GormModel: models.GormModel{ID: 1}, UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+	school := &models.School{GormModel: models.GormModel{ID: 3}, UserID: 1}
+
+	t.Run("administrator gets every informations of the school", func(t *testing.T) {
+		mockSchoolRepo.EXPECT().GetByUser(admin).Return(school, nil)
+		mockInformationsRepo.EXPECT().GetBySchoolId(uint(3)).Return(&[]models.Informations{{Title: "scheduled"}}, nil)
+
+		informations, err := useCase.GetAll(admin)
+		assert.NoError(t, err)
+		assert.Len(t, *informations, 1)
+	})
+
+	t.Run("student gets the visible ones", func(t *testing.T) {
+		student := &models.User{GormModel: models.GormModel{ID: 7}, UserKind: models.NewUserKind(models.STUDENT)}
+
+		mockSchoolRepo.EXPECT().GetByUser(student).Return(school, nil)
+		mockInformationsRepo.EXPECT().GetVisible(uint(3), student.ID, gomock.Any()).Return(&[]models.Informations{}, nil)
+
+		_, err := useCase.GetAll(student)
+		assert.NoError(t, err)
+	})
+
+	t.Run("school not found", func(t *testing.T) {
+		mockSchoolRepo.EXPECT().GetByUser(admin).Return(nil, errors.New("not found"))
+
+		informations, err := useCase.GetAll(admin)
+		assert.Error(t, err)
+		assert.Nil(t, informations)
+	})
+}
@@ -8,7 +8,9 @@ func (mw *MiddlewareManager) CorsMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		ctx.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		ctx.Writer.Header().Set("Access-Control-Allow-Headers", "*")
-		ctx.Writer.Header().Set("Access-Control-Allow-Methods", "GET,PUT,PATCH,POST,DELETE")
+		ctx.Writer.Header().Set("Access-Control-Allow-Methods", "GET,HEAD,PUT,PATCH,POST,DELETE")
+		// Read by the resumable uploads clients
+		ctx.Writer.Header().Set("Access-Control-Expose-Headers", "Location,Upload-Offset,Upload-Length,Tus-Resumable")
 
 		if ctx.Request.Method == "OPTIONS" {
 			ctx.AbortWithStatus(204)
@@ -1,5 +1,10 @@
 package models
 
+import "time"
+
+// Timezone of the campuses created without one
+const DefaultTimezone = "Europe/Paris"
+
 type Campus struct {
 	GormModel
 	Name      string  `json:"name" gorm:"column:name"`
@@ -7,6 +12,28 @@ type Campus struct {
 	Latitude  float64 `json:"latitude" gorm:"column:latitude"`
 	Longitude float64 `json:"longitude" gorm:"column:longitude"`
 	SchoolId  uint    `json:"schoolId" gorm:"column:school_id"`
+	// IANA name, the times of the courses and events of the campus are written in it
+	Timezone string `json:"timezone" gorm:"column:timezone;default:Europe/Paris"`
+}
+
+func (c *Campus) TimeLocation() *time.Location {
+	return TimeLocation(c.Timezone)
+}
+
+// Location of the IANA timezone, the default one when it is empty or unknown
+func TimeLocation(timezone string) *time.Location {
+	if timezone != "" {
+		if location, err := time.LoadLocation(timezone); err == nil {
+			return location
+		}
+	}
+
+	location, err := time.LoadLocation(DefaultTimezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
 }
 
 type CampusCreate struct {
@@ -14,6 +41,8 @@ type CampusCreate struct {
 	Location  string  `json:"location" binding:"required"`
 	Latitude  float64 `json:"latitude"`
 	Longitude float64 `json:"longitude"`
+	// Europe/Paris when empty
+	Timezone string `json:"timezone"`
 }
 
 type CampusUpdate struct {
@@ -21,6 +50,8 @@ type CampusUpdate struct {
 	Location  string  `json:"location" binding:"required"`
 	Latitude  float64 `json:"latitude"`
 	Longitude float64 `json:"longitude"`
+	// Europe/Paris when empty
+	Timezone string `json:"timezone"`
 }
 
 type LocationInput struct {
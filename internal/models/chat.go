@@ -1,22 +1,198 @@
 package models
 
+import (
+	"fmt"
+	"time"
+)
+
+type ChannelKind string
+
+const (
+	CHANNEL_DIRECT        ChannelKind = "direct"
+	CHANNEL_CLASS         ChannelKind = "class"
+	CHANNEL_COURSE        ChannelKind = "course"
+	CHANNEL_PROJECT_GROUP ChannelKind = "project_group"
+)
+
+// Content of the tombstones left by deleted messages
+const MessageDeletedContent = "Message deleted"
+
 type Message struct {
 	GormModel
-	Content   string `json:"content" gorm:"column:content"`
-	ChannelId uint   `json:"channelId" gorm:"column:channel_id"`
-	SenderId  uint   `json:"senderId" gorm:"column:sender_id"`
+	Content   string     `json:"content" gorm:"column:content"`
+	ChannelId uint       `json:"channelId" gorm:"column:channel_id"`
+	SenderId  uint       `json:"senderId" gorm:"column:sender_id"`
+	Sender    *User      `json:"sender,omitempty" gorm:"foreignKey:SenderId;references:ID"`
+	EditedAt  *time.Time `json:"editedAt" gorm:"column:edited_at"`
+	// Deleted by the sender, the message stays in the history as a tombstone
+	RemovedAt   *time.Time          `json:"removedAt" gorm:"column:removed_at"`
+	Attachments []MessageAttachment `json:"attachments" gorm:"foreignKey:MessageId"`
+}
+
+const (
+	MessageAttachmentMaxSize  = 20 << 20
+	MessageAttachmentMaxCount = 10
+)
+
+// Content types detected by http.DetectContentType that can be attached to messages
+var MessageAttachmentContentTypes = []string{
+	"image/jpeg",
+	"image/png",
+	"image/gif",
+	"image/webp",
+	"application/pdf",
+	"application/zip",
+	"text/plain; charset=utf-8",
+}
+
+// Uploaded to a channel before the message it is sent with, MessageId is set once sent
+type MessageAttachment struct {
+	GormModel
+	ChannelId     uint   `json:"channelId" gorm:"column:channel_id"`
+	UploaderId    uint   `json:"uploaderId" gorm:"column:uploader_id"`
+	MessageId     *uint  `json:"messageId" gorm:"column:message_id;index"`
+	Name          string `json:"name" gorm:"column:name"`
+	ContentType   string `json:"contentType" gorm:"column:content_type"`
+	Size          int64  `json:"size" gorm:"column:size"`
+	Path          string `json:"-" gorm:"column:path"`
+	ThumbnailPath string `json:"-" gorm:"column:thumbnail_path"`
+	// Images have a thumbnail served by the thumbnail route
+	HasThumbnail bool `json:"hasThumbnail" gorm:"column:has_thumbnail"`
 }
 
+// Previous content of an edited or deleted message, only shown to moderators
+type MessageRevision struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	MessageId uint      `json:"messageId" gorm:"column:message_id;index"`
+	Content   string    `json:"content" gorm:"column:content"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type MessageHistory struct {
+	Message   Message           `json:"message"`
+	Revisions []MessageRevision `json:"revisions"`
+}
+
+type MessageAttachmentCreate struct {
+	Name string
+	Byte []byte
+}
+
+type MessageUpdate struct {
+	Content string `json:"content" binding:"required"`
+}
+
+// Direct channels are between FirstUser and SecondUser, the other kinds are provisioned
+// for the class, course or project group they reference and their members kept in sync
 type Channel struct {
 	GormModel
-	FirstUserId  uint      `json:"firstUserId" gorm:"column:first_user_id"`
-	SecondUserId uint      `json:"secondUserId" gorm:"column:second_user_id"`
-	FirstUser    User      `json:"firstUser" gorm:"foreignKey:FirstUserId;references:ID"`
-	SecondUser   User      `json:"secondUser" gorm:"foreignKey:SecondUserId;references:ID"`
-	Messages     []Message `json:"messages" gorm:"foreignKey:ChannelId"`
+	Kind         ChannelKind     `json:"kind" gorm:"column:kind;default:direct"`
+	Name         string          `json:"name" gorm:"column:name"`
+	FirstUserId  *uint           `json:"firstUserId" gorm:"column:first_user_id"`
+	SecondUserId *uint           `json:"secondUserId" gorm:"column:second_user_id"`
+	FirstUser    *User           `json:"firstUser,omitempty" gorm:"foreignKey:FirstUserId;references:ID"`
+	SecondUser   *User           `json:"secondUser,omitempty" gorm:"foreignKey:SecondUserId;references:ID"`
+	ClassId      *uint           `json:"classId" gorm:"column:class_id"`
+	CourseId     *uint           `json:"courseId" gorm:"column:course_id"`
+	ProjectId    *uint           `json:"projectId" gorm:"column:project_id"`
+	ProjectGroup *uint           `json:"projectGroup" gorm:"column:project_group"`
+	Members      []ChannelMember `json:"members" gorm:"foreignKey:ChannelId"`
+	// Filled for the requesting user, the history is paginated with MessagePage
+	LastMessage *Message `json:"lastMessage" gorm:"-"`
+	UnreadCount int64    `json:"unreadCount" gorm:"-"`
+}
+
+type ChannelMember struct {
+	ChannelId uint `json:"channelId" gorm:"column:channel_id;primaryKey"`
+	UserId    uint `json:"userId" gorm:"column:user_id;primaryKey"`
+	User      User `json:"user" gorm:"foreignKey:UserId;references:ID"`
+	// Messages after this one are unread, nil when nothing was read yet
+	LastReadMessageId *uint     `json:"lastReadMessageId" gorm:"column:last_read_message_id"`
+	CreatedAt         time.Time `json:"createdAt"`
+}
+
+type ChannelUnread struct {
+	ChannelId uint  `json:"channelId" gorm:"column:channel_id"`
+	Count     int64 `json:"count" gorm:"column:count"`
+}
+
+// Full text search vector of a message, the query must use the exact same expression
+// for the index created in the database package to be used
+const MessageSearchVector = `to_tsvector('simple', content)`
+
+// Matches are highlighted with <mark> tags in the snippet, Cursor is given as "before" to the history
+// to load the page ending with the message
+type MessageSearchResult struct {
+	Message
+	ChannelKind ChannelKind `json:"channelKind" gorm:"column:channel_kind"`
+	ChannelName string      `json:"channelName" gorm:"column:channel_name"`
+	Snippet     string      `json:"snippet" gorm:"column:snippet"`
+	Cursor      uint        `json:"cursor" gorm:"-"`
+}
+
+// Newest matches first, NextCursor is given as "before" to load the older ones
+type MessageSearchPage struct {
+	Results    []MessageSearchResult `json:"results"`
+	NextCursor *uint                 `json:"nextCursor"`
+}
+
+// Messages in chronological order, NextCursor is given as "before" to load the previous page
+type MessagePage struct {
+	Messages   []Message `json:"messages"`
+	NextCursor *uint     `json:"nextCursor"`
 }
 
 type ChannelCreate struct {
 	FirstUserId  uint `json:"firstUserId"`
 	SecondUserId uint `json:"secondUserId"`
 }
+
+type ChannelRead struct {
+	// Latest message of the channel when not set
+	MessageId *uint `json:"messageId"`
+}
+
+type UnreadSummary struct {
+	Total    int64           `json:"total"`
+	Channels []ChannelUnread `json:"channels"`
+}
+
+type ChatEventType string
+
+const (
+	CHAT_EVENT_MESSAGE  ChatEventType = "message"
+	CHAT_EVENT_READ     ChatEventType = "read"
+	CHAT_EVENT_TYPING   ChatEventType = "typing"
+	CHAT_EVENT_PRESENCE ChatEventType = "presence"
+	CHAT_EVENT_EDIT     ChatEventType = "edit"
+	CHAT_EVENT_DELETE   ChatEventType = "delete"
+	CHAT_EVENT_ERROR    ChatEventType = "error"
+)
+
+// Frame sent to the channel sockets, message, edit and delete events keep the fields of the
+// messages and the other events are about UserId
+type ChatEvent struct {
+	Type      ChatEventType `json:"type"`
+	ChannelId uint          `json:"channelId"`
+	Id        uint          `json:"id,omitempty"`
+	Content   string        `json:"content,omitempty"`
+	SenderId  uint          `json:"senderId,omitempty"`
+	CreatedAt *time.Time    `json:"createdAt,omitempty"`
+	EditedAt  *time.Time    `json:"editedAt,omitempty"`
+	UserId    uint          `json:"userId,omitempty"`
+	// Message events
+	Attachments []MessageAttachment `json:"attachments,omitempty"`
+	// Read events, the user read every message up to MessageId
+	MessageId uint `json:"messageId,omitempty"`
+	// Typing events, false when the user stopped typing
+	Typing *bool `json:"typing,omitempty"`
+	// Presence events, online while the user has an open socket
+	Online *bool `json:"online,omitempty"`
+	// Error events, only sent to the client whose frame failed
+	Status int    `json:"status,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+func ChatChannelTopic(channelId uint) string {
+	return fmt.Sprintf("chat:channel:%d", channelId)
+}
@@ -1,13 +1,40 @@
 package models
 
+import "time"
+
+type DocumentScanStatus string
+
+const (
+	DOCUMENT_SCAN_PENDING  DocumentScanStatus = "pending"
+	DOCUMENT_SCAN_CLEAN    DocumentScanStatus = "clean"
+	DOCUMENT_SCAN_INFECTED DocumentScanStatus = "infected"
+)
+
+// Full text search vector of a document, the query must use the exact same
+// expression for the index created in the database package to be used
+const DocumentSearchVector = `setweight(to_tsvector('simple', coalesce(name, '')), 'A') || setweight(to_tsvector('simple', coalesce(content, '')), 'B')`
+
+// Delimiters around matches in search snippets, replaced by <mark> tags once the snippet is escaped
+const (
+	DocumentHighlightStart = "\x02"
+	DocumentHighlightStop  = "\x03"
+)
+
 type Document struct {
 	GormModel
-	Name     string `json:"name" gorm:"column:name"`
-	Path     string `json:"path" gorm:"column:path"`
-	SchoolId uint   `json:"schoolId" gorm:"column:school_id"`
-	UserId   uint   `json:"userId" gorm:"column:user_id"`
-	CourseId *uint  `json:"-" gorm:"column:course_id"`
-	Course   Course `json:"course" gorm:"foreignKey:course_id;references:ID"`
+	Name        string             `json:"name" gorm:"column:name"`
+	Path        string             `json:"path" gorm:"column:path"`
+	SchoolId    uint               `json:"schoolId" gorm:"column:school_id"`
+	UserId      uint               `json:"userId" gorm:"column:user_id"`
+	CourseId    *uint              `json:"-" gorm:"column:course_id"`
+	Course      Course             `json:"course" gorm:"foreignKey:course_id;references:ID"`
+	ScanStatus  DocumentScanStatus `json:"scanStatus" gorm:"column:scan_status;default:pending"`
+	ContentType string             `json:"contentType" gorm:"column:content_type"`
+	PreviewPath string             `json:"-" gorm:"column:preview_path"`
+	PreviewUrl  string             `json:"previewUrl" gorm:"column:preview_url"`
+	Content     string             `json:"-" gorm:"column:content"`
+	// Set once the files of a deleted document have been removed from the bucket
+	PurgedAt *time.Time `json:"-" gorm:"column:purged_at"`
 }
 
 type DocumentCreate struct {
@@ -15,3 +42,39 @@ type DocumentCreate struct {
 	Byte     []byte
 	CourseId *uint
 }
+
+type DocumentSearchResult struct {
+	Document
+	Snippet string  `json:"snippet" gorm:"column:snippet"`
+	Rank    float64 `json:"rank" gorm:"column:rank"`
+}
+
+type DocumentReconcileReport struct {
+	// Files in the bucket that no document references
+	OrphanedFiles []string `json:"orphanedFiles"`
+	// Documents whose file is missing from the bucket
+	DanglingDocuments []uint `json:"danglingDocuments"`
+	Cleaned           bool   `json:"cleaned"`
+}
+
+const (
+	DocumentUploadMaxLength = 2 << 30
+	DocumentUploadMaxChunk  = 32 << 20
+)
+
+// Resumable upload, the chunks are stored under uploads/<id>/ until the document is created
+type DocumentUpload struct {
+	GormModel
+	Name       string `json:"name" gorm:"column:name"`
+	UserId     uint   `json:"userId" gorm:"column:user_id"`
+	CourseId   *uint  `json:"courseId" gorm:"column:course_id"`
+	Length     int64  `json:"length" gorm:"column:upload_length"`
+	Offset     int64  `json:"offset" gorm:"column:upload_offset;default:0"`
+	DocumentId *uint  `json:"documentId" gorm:"column:document_id"`
+}
+
+type DocumentUploadCreate struct {
+	Name     string
+	Length   int64
+	CourseId *uint
+}
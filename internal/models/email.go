@@ -0,0 +1,31 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type EmailStatus string
+
+const (
+	EMAIL_PENDING EmailStatus = "pending"
+	EMAIL_SENT    EmailStatus = "sent"
+	// Failed too many times, only retried by an admin
+	EMAIL_DEAD EmailStatus = "dead"
+)
+
+// Email of the outbox, saved with the change it is about and sent by the outbox worker.
+// Data is what Template is rendered with, it is hidden as it holds the invitation and reset links
+type Email struct {
+	GormModel
+	To            string          `json:"to" gorm:"column:to_address"`
+	Subject       string          `json:"subject" gorm:"column:subject"`
+	Template      string          `json:"template" gorm:"column:template"`
+	Locale        Locale          `json:"locale" gorm:"column:locale"`
+	Data          json.RawMessage `json:"-" gorm:"column:data;type:jsonb"`
+	Status        EmailStatus     `json:"status" gorm:"column:status;index:idx_emails_due,priority:1"`
+	Attempts      int             `json:"attempts" gorm:"column:attempts"`
+	NextAttemptAt time.Time       `json:"nextAttemptAt" gorm:"column:next_attempt_at;index:idx_emails_due,priority:2"`
+	LastError     string          `json:"lastError" gorm:"column:last_error"`
+	SentAt        *time.Time      `json:"sentAt" gorm:"column:sent_at"`
+}
@@ -0,0 +1,113 @@
+package models
+
+import "time"
+
+type EventTargetKind string
+
+const (
+	EVENT_TARGET_CLASS EventTargetKind = "class"
+	EVENT_TARGET_PATH  EventTargetKind = "path"
+	// TargetId is the user kind, students or teachers
+	EVENT_TARGET_USER_KIND EventTargetKind = "userKind"
+)
+
+type EventRsvpStatus string
+
+const (
+	EVENT_RSVP_GOING     EventRsvpStatus = "going"
+	EVENT_RSVP_NOT_GOING EventRsvpStatus = "notGoing"
+	EVENT_RSVP_MAYBE     EventRsvpStatus = "maybe"
+)
+
+// School event like an open day, a jury or a party, shown in the timetables of its audience
+type Event struct {
+	GormModel
+	Title       string    `json:"title" gorm:"column:title"`
+	Description string    `json:"description" gorm:"column:description"`
+	SchoolId    uint      `json:"schoolId" gorm:"column:school_id"`
+	CampusId    uint      `json:"campusId" gorm:"column:campus_id"`
+	Campus      Campus    `json:"campus" gorm:"foreignKey:CampusId;references:ID"`
+	StartAt     time.Time `json:"startAt" gorm:"column:start_at"`
+	EndAt       time.Time `json:"endAt" gorm:"column:end_at"`
+	// Users going once it is full are waitlisted, no limit when 0
+	Capacity uint `json:"capacity" gorm:"column:capacity"`
+	// Whole school when empty
+	Targets []EventTarget `json:"targets" gorm:"foreignKey:EventId"`
+	// Answer of the user, not loaded for the administrator
+	Rsvp       *EventRsvp `json:"rsvp,omitempty" gorm:"foreignKey:EventId"`
+	Going      int64      `json:"going" gorm:"-"`
+	Maybe      int64      `json:"maybe" gorm:"-"`
+	NotGoing   int64      `json:"notGoing" gorm:"-"`
+	Waitlisted int64      `json:"waitlisted" gorm:"-"`
+}
+
+// Targets of the same kind are alternatives and every kind used must match
+type EventTarget struct {
+	EventId  uint            `json:"-" gorm:"column:event_id;primaryKey"`
+	Kind     EventTargetKind `json:"kind" gorm:"column:kind;primaryKey"`
+	TargetId uint            `json:"targetId" gorm:"column:target_id;primaryKey"`
+}
+
+// Waitlisted users answered going once the event was full, the oldest answer is the
+// first to get a freed place
+type EventRsvp struct {
+	EventId    uint            `json:"eventId" gorm:"column:event_id;primaryKey"`
+	UserId     uint            `json:"userId" gorm:"column:user_id;primaryKey"`
+	User       *User           `json:"user,omitempty" gorm:"foreignKey:UserId;references:ID"`
+	Status     EventRsvpStatus `json:"status" gorm:"column:status"`
+	Waitlisted bool            `json:"waitlisted" gorm:"column:waitlisted"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	UpdatedAt  time.Time       `json:"updatedAt"`
+}
+
+type EventRsvpCount struct {
+	EventId    uint            `gorm:"column:event_id"`
+	Status     EventRsvpStatus `gorm:"column:status"`
+	Waitlisted bool            `gorm:"column:waitlisted"`
+	Count      int64           `gorm:"column:count"`
+}
+
+type EventCreate struct {
+	Title       string `json:"title" binding:"required" validate:"max=128"`
+	Description string `json:"description"`
+	CampusId    *uint  `json:"campusId" binding:"required"`
+	// Unix timestamps
+	StartAt  *uint               `json:"startAt" binding:"required"`
+	EndAt    *uint               `json:"endAt" binding:"required"`
+	Capacity uint                `json:"capacity"`
+	Targets  []EventTargetCreate `json:"targets" binding:"dive" validate:"dive"`
+}
+
+// The audience can't change once notified
+type EventUpdate struct {
+	Title       string `json:"title" binding:"required" validate:"max=128"`
+	Description string `json:"description"`
+	CampusId    *uint  `json:"campusId" binding:"required"`
+	// Unix timestamps
+	StartAt  *uint `json:"startAt" binding:"required"`
+	EndAt    *uint `json:"endAt" binding:"required"`
+	Capacity uint  `json:"capacity"`
+}
+
+type EventTargetCreate struct {
+	Kind     EventTargetKind `json:"kind" binding:"required" validate:"oneof=class path userKind"`
+	TargetId *uint           `json:"targetId" binding:"required"`
+}
+
+type EventRsvpUpdate struct {
+	Status EventRsvpStatus `json:"status" binding:"required" validate:"oneof=going notGoing maybe"`
+}
+
+// Answers of the audience, the waitlist in its order
+type EventAttendees struct {
+	Going    []EventRsvp `json:"going"`
+	Maybe    []EventRsvp `json:"maybe"`
+	NotGoing []EventRsvp `json:"notGoing"`
+	Waitlist []EventRsvp `json:"waitlist"`
+}
+
+// Events ending after From, and starting before To when it is set
+type EventPeriod struct {
+	From time.Time
+	To   *time.Time
+}
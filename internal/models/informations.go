@@ -1,13 +1,113 @@
 package models
 
+import "time"
+
+type InformationsTargetKind string
+
+const (
+	INFORMATIONS_TARGET_CLASS  InformationsTargetKind = "class"
+	INFORMATIONS_TARGET_PATH   InformationsTargetKind = "path"
+	INFORMATIONS_TARGET_CAMPUS InformationsTargetKind = "campus"
+	// TargetId is the user kind, students or teachers
+	INFORMATIONS_TARGET_USER_KIND InformationsTargetKind = "userKind"
+)
+
 type Informations struct {
 	GormModel
-	Title       string `json:"title" gorm:"column:title"`
-	Description string `json:"description" gorm:"column:description"`
-	SchoolId    uint   `json:"schoolId" gorm:"column:school_id"`
+	Title string `json:"title" gorm:"column:title"`
+	// Markdown source, only show DescriptionHtml as HTML
+	Description     string     `json:"description" gorm:"column:description"`
+	DescriptionHtml string     `json:"descriptionHtml" gorm:"-"`
+	EditedAt        *time.Time `json:"editedAt" gorm:"column:edited_at"`
+	SchoolId        uint       `json:"schoolId" gorm:"column:school_id"`
+	// Hidden from the students and teachers before
+	PublishAt time.Time `json:"publishAt" gorm:"column:publish_at;not null;default:CURRENT_TIMESTAMP"`
+	// Hidden from the students and teachers after, never expires when nil
+	ExpiresAt  *time.Time `json:"expiresAt" gorm:"column:expires_at"`
+	Pinned     bool       `json:"pinned" gorm:"column:pinned"`
+	DocumentId *uint      `json:"documentId" gorm:"column:document_id"`
+	Document   *Document  `json:"document,omitempty" gorm:"foreignKey:DocumentId;references:ID"`
+	// The audience must confirm they read it, like exam rules
+	RequiresAck bool `json:"requiresAck" gorm:"column:requires_ack"`
+	// Receipt of the user reading the informations, not loaded for the administrator
+	Receipt *InformationsReceipt `json:"receipt,omitempty" gorm:"foreignKey:InformationsId"`
+	// Whole school when empty
+	Targets []InformationsTarget `json:"targets" gorm:"foreignKey:InformationsId"`
+	// Set while the audience of informations scheduled for later was not notified
+	NotificationPending bool `json:"-" gorm:"column:notification_pending"`
+}
+
+// Targets of the same kind are alternatives and every kind used must match, classes A
+// and B with the teachers kind are the teachers of class A or B
+type InformationsTarget struct {
+	InformationsId uint                   `json:"-" gorm:"column:informations_id;primaryKey"`
+	Kind           InformationsTargetKind `json:"kind" gorm:"column:kind;primaryKey"`
+	TargetId       uint                   `json:"targetId" gorm:"column:target_id;primaryKey"`
 }
 
 type InformationsCreate struct {
 	Title       string `json:"title" binding:"required"`
 	Description string `json:"description" binding:"required"`
+	// Unix timestamps, published now when not set
+	PublishAt   *uint                      `json:"publishAt"`
+	ExpiresAt   *uint                      `json:"expiresAt"`
+	Pinned      bool                       `json:"pinned"`
+	DocumentId  *uint                      `json:"documentId"`
+	RequiresAck bool                       `json:"requiresAck"`
+	Targets     []InformationsTargetCreate `json:"targets" binding:"dive" validate:"dive"`
+}
+
+// Same fields as the creation, the targets and the publication date can't change once
+// the audience may have been notified
+type InformationsUpdate struct {
+	Title       string `json:"title" binding:"required"`
+	Description string `json:"description" binding:"required"`
+	// Unix timestamp, never expires when not set
+	ExpiresAt   *uint `json:"expiresAt"`
+	Pinned      bool  `json:"pinned"`
+	DocumentId  *uint `json:"documentId"`
+	RequiresAck bool  `json:"requiresAck"`
+}
+
+type InformationsTargetCreate struct {
+	Kind     InformationsTargetKind `json:"kind" binding:"required" validate:"oneof=class path campus userKind"`
+	TargetId *uint                  `json:"targetId" binding:"required"`
+}
+
+// First read and acknowledgement of the informations by a user
+type InformationsReceipt struct {
+	InformationsId uint       `json:"informationsId" gorm:"column:informations_id;primaryKey"`
+	UserId         uint       `json:"userId" gorm:"column:user_id;primaryKey"`
+	ReadAt         time.Time  `json:"readAt" gorm:"column:read_at"`
+	AcknowledgedAt *time.Time `json:"acknowledgedAt" gorm:"column:acknowledged_at"`
+}
+
+// User of the audience who did not acknowledge yet, or did not read yet when no
+// acknowledgement is required
+type InformationsPendingUser struct {
+	User
+	ReadAt *time.Time `json:"readAt" gorm:"column:read_at"`
+}
+
+type InformationsReceipts struct {
+	Audience     int64                     `json:"audience"`
+	Read         int64                     `json:"read"`
+	Acknowledged int64                     `json:"acknowledged"`
+	Pending      []InformationsPendingUser `json:"pending"`
+}
+
+// Title and description of the informations before an edit
+type InformationsRevision struct {
+	ID              uint      `json:"id" gorm:"primaryKey"`
+	InformationsId  uint      `json:"informationsId" gorm:"column:informations_id;index"`
+	Title           string    `json:"title" gorm:"column:title"`
+	Description     string    `json:"description" gorm:"column:description"`
+	DescriptionHtml string    `json:"descriptionHtml" gorm:"-"`
+	EditorId        uint      `json:"editorId" gorm:"column:editor_id"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+type InformationsHistory struct {
+	Informations Informations           `json:"informations"`
+	Revisions    []InformationsRevision `json:"revisions"`
 }
@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+type MessageReportStatus string
+
+const (
+	MESSAGE_REPORT_PENDING   MessageReportStatus = "pending"
+	MESSAGE_REPORT_DISMISSED MessageReportStatus = "dismissed"
+	// The report was right, the message may have been deleted or its sender muted
+	MESSAGE_REPORT_ACTIONED MessageReportStatus = "actioned"
+)
+
+// Reports are reviewed by the administrators of the reporter school
+type MessageReport struct {
+	GormModel
+	MessageId  uint                `json:"messageId" gorm:"column:message_id;uniqueIndex:idx_message_reports_reporter"`
+	Message    Message             `json:"message" gorm:"foreignKey:MessageId;references:ID"`
+	ReporterId uint                `json:"reporterId" gorm:"column:reporter_id;uniqueIndex:idx_message_reports_reporter"`
+	Reporter   *User               `json:"reporter,omitempty" gorm:"foreignKey:ReporterId;references:ID"`
+	SchoolId   uint                `json:"schoolId" gorm:"column:school_id;index"`
+	Reason     string              `json:"reason" gorm:"column:reason"`
+	Status     MessageReportStatus `json:"status" gorm:"column:status;default:pending"`
+	ReviewerId *uint               `json:"reviewerId" gorm:"column:reviewer_id"`
+	ReviewedAt *time.Time          `json:"reviewedAt" gorm:"column:reviewed_at"`
+}
+
+type MessageReportCreate struct {
+	Reason string `json:"reason" binding:"required" validate:"min=1,max=1024"`
+}
+
+type MessageReportReview struct {
+	Status MessageReportStatus `json:"status" binding:"required"`
+	// Replace the reported message by a tombstone
+	DeleteMessage bool `json:"deleteMessage"`
+}
+
+// Blocked users can't open a direct channel with the blocker or message it
+type UserBlock struct {
+	BlockerId uint      `json:"blockerId" gorm:"column:blocker_id;primaryKey"`
+	BlockedId uint      `json:"blockedId" gorm:"column:blocked_id;primaryKey"`
+	Blocked   User      `json:"blocked" gorm:"foreignKey:BlockedId;references:ID"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Muted users can't send, edit or attach anything in chat until Until
+type ChatMute struct {
+	GormModel
+	UserId    uint      `json:"userId" gorm:"column:user_id;index"`
+	User      *User     `json:"user,omitempty" gorm:"foreignKey:UserId;references:ID"`
+	SchoolId  uint      `json:"schoolId" gorm:"column:school_id;index"`
+	MutedById uint      `json:"mutedById" gorm:"column:muted_by_id"`
+	Reason    string    `json:"reason" gorm:"column:reason"`
+	Until     time.Time `json:"until" gorm:"column:until"`
+}
+
+type ChatMuteCreate struct {
+	UserId uint      `json:"userId" binding:"required"`
+	Reason string    `json:"reason" validate:"max=1024"`
+	Until  time.Time `json:"until" binding:"required"`
+}
@@ -0,0 +1,165 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+type NotificationType string
+
+const (
+	NOTIFICATION_NOTE         NotificationType = "note"
+	NOTIFICATION_SCHEDULE     NotificationType = "schedule"
+	NOTIFICATION_INFORMATIONS NotificationType = "informations"
+	NOTIFICATION_PROJECT      NotificationType = "project"
+	NOTIFICATION_EVENT        NotificationType = "event"
+	// Only used for the preferences, chat messages are not kept as notifications
+	NOTIFICATION_CHAT NotificationType = "chat"
+)
+
+// Types the users have preferences for
+var NotificationTypes = []NotificationType{
+	NOTIFICATION_NOTE,
+	NOTIFICATION_SCHEDULE,
+	NOTIFICATION_INFORMATIONS,
+	NOTIFICATION_PROJECT,
+	NOTIFICATION_EVENT,
+	NOTIFICATION_CHAT,
+}
+
+type NotificationMessageKey string
+
+// Texts of the email catalogs, rendered in the locale of each recipient with the params
+const (
+	NOTIFICATION_MESSAGE_NOTE_CREATED          NotificationMessageKey = "note.created"
+	NOTIFICATION_MESSAGE_NOTE_UPDATED          NotificationMessageKey = "note.updated"
+	NOTIFICATION_MESSAGE_SCHEDULE_CREATED      NotificationMessageKey = "schedule.created"
+	NOTIFICATION_MESSAGE_SCHEDULE_UPDATED      NotificationMessageKey = "schedule.updated"
+	NOTIFICATION_MESSAGE_SCHEDULE_CANCELLED    NotificationMessageKey = "schedule.cancelled"
+	NOTIFICATION_MESSAGE_PROJECT_CREATED       NotificationMessageKey = "project.created"
+	NOTIFICATION_MESSAGE_INFORMATIONS          NotificationMessageKey = "informations.published"
+	NOTIFICATION_MESSAGE_INFORMATIONS_REMINDER NotificationMessageKey = "informations.reminder"
+	NOTIFICATION_MESSAGE_EVENT_CREATED         NotificationMessageKey = "event.created"
+	NOTIFICATION_MESSAGE_EVENT_PROMOTED        NotificationMessageKey = "event.promoted"
+	NOTIFICATION_MESSAGE_CHAT_MESSAGE          NotificationMessageKey = "chat.message"
+	NOTIFICATION_MESSAGE_CHAT_ATTACHMENT       NotificationMessageKey = "chat.attachment"
+)
+
+// Times in the params are written with this layout, in the timezone of the campus
+const NotificationTimeLayout = "02/01/2006 15:04"
+
+// RefId is the id of the note, schedule, informations, project or event the notification is about.
+// Title and Body are rendered from the message key in the locale of the user
+type Notification struct {
+	GormModel
+	UserId        uint                   `json:"userId" gorm:"column:user_id;index:idx_notifications_user"`
+	Type          NotificationType       `json:"type" gorm:"column:type"`
+	MessageKey    NotificationMessageKey `json:"messageKey" gorm:"column:message_key"`
+	MessageParams map[string]string      `json:"messageParams" gorm:"column:message_params;type:jsonb;serializer:json"`
+	Title         string                 `json:"title" gorm:"column:title"`
+	Body          string                 `json:"body" gorm:"column:body"`
+	RefId         *uint                  `json:"refId" gorm:"column:ref_id"`
+	ReadAt        *time.Time             `json:"readAt" gorm:"column:read_at"`
+}
+
+// Newest notifications first, NextCursor is given as "before" to load the older ones
+type NotificationPage struct {
+	Notifications []Notification `json:"notifications"`
+	Unread        int64          `json:"unread"`
+	NextCursor    *uint          `json:"nextCursor"`
+}
+
+// Frame sent to the notification sockets of the user
+type NotificationEventType string
+
+const (
+	NOTIFICATION_EVENT_NEW      NotificationEventType = "notification"
+	NOTIFICATION_EVENT_READ     NotificationEventType = "read"
+	NOTIFICATION_EVENT_READ_ALL NotificationEventType = "readAll"
+)
+
+type NotificationEvent struct {
+	Type         NotificationEventType `json:"type"`
+	Notification *Notification         `json:"notification,omitempty"`
+	// Read events, the count of unread notifications is given after reading
+	NotificationId uint   `json:"notificationId,omitempty"`
+	Unread         *int64 `json:"unread,omitempty"`
+}
+
+func NotificationUserTopic(userId uint) string {
+	return fmt.Sprintf("notifications:user:%d", userId)
+}
+
+type PushPlatform string
+
+const (
+	PUSH_ANDROID PushPlatform = "android"
+	PUSH_IOS     PushPlatform = "ios"
+	PUSH_WEB     PushPlatform = "web"
+)
+
+// A token belongs to a single device, registering it again moves it to the new user
+type PushDevice struct {
+	GormModel
+	UserId   uint         `json:"userId" gorm:"column:user_id;index:idx_push_devices_user"`
+	Token    string       `json:"token" gorm:"column:token;uniqueIndex:idx_push_devices_token"`
+	Platform PushPlatform `json:"platform" gorm:"column:platform"`
+}
+
+type PushDeviceCreate struct {
+	Token    string       `json:"token" binding:"required" validate:"max=4096"`
+	Platform PushPlatform `json:"platform" binding:"required" validate:"oneof=android ios web"`
+}
+
+type EmailFrequency string
+
+const (
+	EMAIL_NEVER   EmailFrequency = "never"
+	EMAIL_INSTANT EmailFrequency = "instant"
+	// Gathered in a digest email sent once a day or once a week
+	EMAIL_DAILY  EmailFrequency = "daily"
+	EMAIL_WEEKLY EmailFrequency = "weekly"
+)
+
+// How a user is told about a type of event, InApp is ignored for chat messages as they
+// are always sent to the channel sockets. Push and email are only used for chat messages
+// while the user is offline
+type NotificationPreference struct {
+	UserId    uint             `json:"-" gorm:"column:user_id;primaryKey"`
+	Type      NotificationType `json:"type" gorm:"column:type;primaryKey"`
+	InApp     bool             `json:"inApp" gorm:"column:in_app"`
+	Push      bool             `json:"push" gorm:"column:push"`
+	Email     EmailFrequency   `json:"email" gorm:"column:email"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// Used until the user changes it
+func DefaultNotificationPreference(userId uint, notificationType NotificationType) NotificationPreference {
+	return NotificationPreference{
+		UserId: userId,
+		Type:   notificationType,
+		InApp:  true,
+		Push:   true,
+		Email:  EMAIL_NEVER,
+	}
+}
+
+type NotificationPreferenceUpdate struct {
+	InApp *bool          `json:"inApp" binding:"required"`
+	Push  *bool          `json:"push" binding:"required"`
+	Email EmailFrequency `json:"email" binding:"required" validate:"oneof=never instant daily weekly"`
+}
+
+// Event waiting for the next digest email of the user, rendered in the locale the user has
+// when it is sent. Items queued before the message keys only have their title and body
+type NotificationDigestItem struct {
+	ID            uint                   `json:"id" gorm:"primarykey"`
+	UserId        uint                   `json:"userId" gorm:"column:user_id;index:idx_notification_digest_items_user"`
+	Frequency     EmailFrequency         `json:"frequency" gorm:"column:frequency"`
+	Type          NotificationType       `json:"type" gorm:"column:type"`
+	MessageKey    NotificationMessageKey `json:"messageKey" gorm:"column:message_key"`
+	MessageParams map[string]string      `json:"messageParams" gorm:"column:message_params;type:jsonb;serializer:json"`
+	Title         string                 `json:"title" gorm:"column:title"`
+	Body          string                 `json:"body" gorm:"column:body"`
+	CreatedAt     time.Time              `json:"createdAt"`
+}
@@ -5,7 +5,7 @@ type Path struct {
 	LongName  string `json:"longName" gorm:"column:long_name"`
 	ShortName string `json:"shortName" gorm:"column:short_name"`
 	SchoolId  uint   `json:"schoolId" gorm:"column:school_id"`
-	School    School `json:"school" gorm:"foreignKey:SchoolId;references:ID""`
+	School    School `json:"school" gorm:"foreignKey:SchoolId;references:ID"`
 }
 
 type PathCreate struct {
@@ -65,6 +65,12 @@ type ScheduleGet struct {
 	Campus   Campus   `json:"campus" binding:"required"`
 }
 
+// Courses and school events of the user
+type Timetable struct {
+	Schedules []Schedule `json:"schedules"`
+	Events    []Event    `json:"events"`
+}
+
 type ScheduleSignatureGet struct {
 	Students  []User              `json:"students" binding:"required"`
 	Signature []ScheduleSignature `json:"signatures" binding:"required"`
@@ -15,6 +15,8 @@ type SchoolInvite struct {
 	Lastname  string `json:"lastname" binding:"required" validate:"min=1,max=128"`
 	Email     string `json:"email" binding:"required" validate:"min=1,max=128"`
 	Type      string `json:"type" binding:"required" `
+	// Language of the invitation and the next emails, french when not set
+	Locale Locale `json:"locale" validate:"omitempty,oneof=fr en"`
 }
 
 type SchoolUpdate struct {
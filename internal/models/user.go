@@ -11,6 +11,16 @@ const (
 	SUPERADMIN    = 3
 )
 
+// Language of the emails sent to the user
+type Locale string
+
+const (
+	LOCALE_FR Locale = "fr"
+	LOCALE_EN Locale = "en"
+
+	DefaultLocale = LOCALE_FR
+)
+
 func NewUserKind(kind UserKind) *UserKind {
 	return &kind
 }
@@ -27,6 +37,7 @@ type User struct {
 	SchoolId          *uint     `json:"schoolId" gorm:"column:school_id"`
 	Class             Class     `json:"class" gorm:"foreignKey:ClassRefer;references:ID"`
 	ClassRefer        *uint     `json:"classRefer" gorm:"column:class_refer"`
+	Locale            Locale    `json:"locale" gorm:"column:locale;default:fr"`
 }
 
 type UserCreate struct {
@@ -55,6 +66,8 @@ type UpdateMe struct {
 	Firstname string `json:"firstname"`
 	Lastname  string `json:"lastname"`
 	Email     string `json:"email"`
+	// The current locale is kept when not set
+	Locale Locale `json:"locale" validate:"omitempty,oneof=fr en"`
 }
 
 type UpdatePasswordMe struct {
@@ -1,21 +1,42 @@
 package usecase
 
 import (
+	"strconv"
+
 	"github.com/esgi-challenge/backend/config"
 	"github.com/esgi-challenge/backend/internal/models"
 	"github.com/esgi-challenge/backend/internal/note"
+	"github.com/esgi-challenge/backend/internal/notification"
 	"github.com/esgi-challenge/backend/pkg/logger"
 	"gorm.io/gorm"
 )
 
 type noteUseCase struct {
-	noteRepo note.Repository
-	cfg      *config.Config
-	logger   logger.Logger
+	noteRepo            note.Repository
+	notificationUseCase notification.UseCase
+	cfg                 *config.Config
+	logger              logger.Logger
+}
+
+func NewNoteUseCase(cfg *config.Config, noteRepo note.Repository, notificationUseCase notification.UseCase, logger logger.Logger) note.UseCase {
+	return &noteUseCase{cfg: cfg, noteRepo: noteRepo, notificationUseCase: notificationUseCase, logger: logger}
 }
 
-func NewNoteUseCase(cfg *config.Config, noteRepo note.Repository, logger logger.Logger) note.UseCase {
-	return &noteUseCase{cfg: cfg, noteRepo: noteRepo, logger: logger}
+// The note is saved even if the student could not be notified
+func (u *noteUseCase) notify(note *models.Note, key models.NotificationMessageKey) {
+	err := u.notificationUseCase.Notify([]uint{note.StudentId}, &models.Notification{
+		Type:       models.NOTIFICATION_NOTE,
+		MessageKey: key,
+		MessageParams: map[string]string{
+			"project": note.Project.Title,
+			"value":   strconv.Itoa(note.Value),
+		},
+		RefId: &note.ID,
+	})
+
+	if err != nil {
+		u.logger.Errorf("Notification: note %d: %v", note.ID, err)
+	}
 }
 
 func (u *noteUseCase) Create(note *models.Note) (*models.Note, error) {
@@ -24,7 +45,14 @@ func (u *noteUseCase) Create(note *models.Note) (*models.Note, error) {
 		return nil, err
 	}
 
-	return u.noteRepo.GetByIdPreload(note.ID)
+	note, err = u.noteRepo.GetByIdPreload(note.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	u.notify(note, models.NOTIFICATION_MESSAGE_NOTE_CREATED)
+
+	return note, nil
 }
 
 func (u *noteUseCase) GetAllByUser(user *models.User) (*[]models.Note, error) {
@@ -58,7 +86,14 @@ func (u *noteUseCase) Update(id uint, updatedNote *models.Note) (*models.Note, e
 		return nil, err
 	}
 
-	return u.noteRepo.GetByIdPreload(note.ID)
+	note, err = u.noteRepo.GetByIdPreload(note.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	u.notify(note, models.NOTIFICATION_MESSAGE_NOTE_UPDATED)
+
+	return note, nil
 }
 
 func (u *noteUseCase) Delete(id uint) error {
@@ -0,0 +1,16 @@
+package notification
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type Handlers interface {
+	GetAll() gin.HandlerFunc
+	MarkRead() gin.HandlerFunc
+	MarkAllRead() gin.HandlerFunc
+	RegisterDevice() gin.HandlerFunc
+	GetDevices() gin.HandlerFunc
+	UnregisterDevice() gin.HandlerFunc
+	GetPreferences() gin.HandlerFunc
+	UpdatePreference() gin.HandlerFunc
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/request"
+	"github.com/gin-gonic/gin"
+)
+
+// Create
+//
+//	@Summary		Register push device
+//	@Description	Register the token of a device to receive push notifications while the user has no open socket. A token already registered is moved to the user
+//	@Tags			Notification
+//	@Accept			json
+//	@Produce		json
+//	@Param			device	body		models.PushDeviceCreate	true	"Device infos"
+//	@Success		201		{object}	models.PushDevice
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/notifications/devices [post]
+func (u *notificationHandlers) RegisterDevice() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		var body models.PushDeviceCreate
+
+		deviceCreate, err := request.ValidateJSON(body, ctx)
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		device, err := u.notificationUseCase.RegisterDevice(user, &deviceCreate)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, device)
+	}
+}
+
+// Read
+//
+//	@Summary		Get push devices
+//	@Description	Get the devices registered by the user
+//	@Tags			Notification
+//	@Produce		json
+//	@Success		200	{object}	[]models.PushDevice
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/notifications/devices [get]
+func (u *notificationHandlers) GetDevices() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		devices, err := u.notificationUseCase.GetDevices(user)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, devices)
+	}
+}
+
+// Delete
+//
+//	@Summary		Unregister push device
+//	@Description	Stop sending push notifications to the device, on logout
+//	@Tags			Notification
+//	@Produce		json
+//	@Param			token	path		string	true	"Device token"
+//	@Success		200		{object}	nil
+//	@Failure		404		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/notifications/devices/{token} [delete]
+func (u *notificationHandlers) UnregisterDevice() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		err = u.notificationUseCase.UnregisterDevice(user, ctx.Params.ByName("token"))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, nil)
+	}
+}
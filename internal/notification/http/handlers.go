@@ -0,0 +1,162 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/notification"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/esgi-challenge/backend/pkg/request"
+	"github.com/gin-gonic/gin"
+)
+
+type notificationHandlers struct {
+	cfg                 *config.Config
+	notificationUseCase notification.UseCase
+	logger              logger.Logger
+}
+
+func NewNotificationHandlers(cfg *config.Config, notificationUseCase notification.UseCase, logger logger.Logger) notification.Handlers {
+	return &notificationHandlers{cfg: cfg, notificationUseCase: notificationUseCase, logger: logger}
+}
+
+// Read
+//
+//	@Summary		Get notifications
+//	@Description	Get the notifications of the user, newest first, with the count of unread ones
+//	@Tags			Notification
+//	@Produce		json
+//	@Param			unread	query		bool	false	"Only the unread notifications"
+//	@Param			before	query		int		false	"Cursor, only notifications older than this notification id"
+//	@Param			limit	query		int		false	"Page size, 50 by default and 100 at most"
+//	@Success		200		{object}	models.NotificationPage
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
+//	@Router			/notifications [get]
+func (u *notificationHandlers) GetAll() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var before *uint
+
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		unread, err := strconv.ParseBool(ctx.DefaultQuery("unread", "false"))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		if beforeStr := ctx.Query("before"); beforeStr != "" {
+			beforeInt, err := strconv.ParseUint(beforeStr, 10, 32)
+
+			if err != nil {
+				ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+				u.logger.Infof("Request: %v", err.Error())
+				return
+			}
+
+			tmp := uint(beforeInt)
+			before = &tmp
+		}
+
+		limit, err := strconv.Atoi(ctx.DefaultQuery("limit", "0"))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		page, err := u.notificationUseCase.GetAll(user, unread, before, limit)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, page)
+	}
+}
+
+// Read
+//
+//	@Summary		Mark notification as read
+//	@Description	Mark the notification read, a read event is sent to the notification sockets of the user
+//	@Tags			Notification
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{object}	models.Notification
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		403	{object}	errorHandler.HttpErr
+//	@Failure		404	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/notifications/{id}/read [post]
+func (u *notificationHandlers) MarkRead() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		notification, err := u.notificationUseCase.MarkRead(user, uint(idInt))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, notification)
+	}
+}
+
+// Read
+//
+//	@Summary		Mark all notifications as read
+//	@Description	Mark every notification of the user read, a readAll event is sent to the notification sockets of the user
+//	@Tags			Notification
+//	@Produce		json
+//	@Success		200	{object}	nil
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/notifications/read-all [post]
+func (u *notificationHandlers) MarkAllRead() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		err = u.notificationUseCase.MarkAllRead(user)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, nil)
+	}
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/request"
+	"github.com/gin-gonic/gin"
+)
+
+// Read
+//
+//	@Summary		Get notification preferences
+//	@Description	Get how the user is told about each type of event, types never changed have the default preference: in-app and push, no email
+//	@Tags			Notification
+//	@Produce		json
+//	@Success		200	{object}	[]models.NotificationPreference
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/notifications/preferences [get]
+func (u *notificationHandlers) GetPreferences() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		preferences, err := u.notificationUseCase.GetPreferences(user)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, preferences)
+	}
+}
+
+// Update
+//
+//	@Summary		Update notification preference
+//	@Description	Choose how the user is told about a type of event (note, schedule, informations, project, event or chat): in-app, push, and an email sent instantly or gathered in a daily or weekly digest
+//	@Tags			Notification
+//	@Accept			json
+//	@Produce		json
+//	@Param			type		path		string								true	"Notification type"
+//	@Param			preference	body		models.NotificationPreferenceUpdate	true	"Preference"
+//	@Success		200			{object}	models.NotificationPreference
+//	@Failure		400			{object}	errorHandler.HttpErr
+//	@Failure		404			{object}	errorHandler.HttpErr
+//	@Failure		500			{object}	errorHandler.HttpErr
+//	@Router			/notifications/preferences/{type} [put]
+func (u *notificationHandlers) UpdatePreference() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.STUDENT)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		var body models.NotificationPreferenceUpdate
+
+		preferenceUpdate, err := request.ValidateJSON(body, ctx)
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.BodyParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		notificationType := models.NotificationType(ctx.Params.ByName("type"))
+		preference, err := u.notificationUseCase.UpdatePreference(user, notificationType, &preferenceUpdate)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, preference)
+	}
+}
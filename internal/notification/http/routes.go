@@ -0,0 +1,17 @@
+package http
+
+import (
+	"github.com/esgi-challenge/backend/internal/notification"
+	"github.com/gin-gonic/gin"
+)
+
+func SetupNotificationRoutes(notificationGroup *gin.RouterGroup, h notification.Handlers) {
+	notificationGroup.GET("", h.GetAll())
+	notificationGroup.POST("/read-all", h.MarkAllRead())
+	notificationGroup.POST("/:id/read", h.MarkRead())
+	notificationGroup.GET("/devices", h.GetDevices())
+	notificationGroup.POST("/devices", h.RegisterDevice())
+	notificationGroup.DELETE("/devices/:token", h.UnregisterDevice())
+	notificationGroup.GET("/preferences", h.GetPreferences())
+	notificationGroup.PUT("/preferences/:type", h.UpdatePreference())
+}
@@ -0,0 +1,337 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/notification/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/notification/repository.go -destination=internal/notification/mock/repository_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	models "github.com/esgi-challenge/backend/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountUnread mocks base method.
+func (m *MockRepository) CountUnread(userId uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnread", userId)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnread indicates an expected call of CountUnread.
+func (mr *MockRepositoryMockRecorder) CountUnread(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnread", reflect.TypeOf((*MockRepository)(nil).CountUnread), userId)
+}
+
+// DeleteDevice mocks base method.
+func (m *MockRepository) DeleteDevice(userId uint, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDevice", userId, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDevice indicates an expected call of DeleteDevice.
+func (mr *MockRepositoryMockRecorder) DeleteDevice(userId, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockRepository)(nil).DeleteDevice), userId, token)
+}
+
+// DeleteDevicesByTokens mocks base method.
+func (m *MockRepository) DeleteDevicesByTokens(tokens []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDevicesByTokens", tokens)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDevicesByTokens indicates an expected call of DeleteDevicesByTokens.
+func (mr *MockRepositoryMockRecorder) DeleteDevicesByTokens(tokens any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevicesByTokens", reflect.TypeOf((*MockRepository)(nil).DeleteDevicesByTokens), tokens)
+}
+
+// GetById mocks base method.
+func (m *MockRepository) GetById(id uint) (*models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", id)
+	ret0, _ := ret[0].(*models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockRepositoryMockRecorder) GetById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockRepository)(nil).GetById), id)
+}
+
+// GetByUser mocks base method.
+func (m *MockRepository) GetByUser(userId uint, unread bool, before uint, limit int) (*[]models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUser", userId, unread, before, limit)
+	ret0, _ := ret[0].(*[]models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUser indicates an expected call of GetByUser.
+func (mr *MockRepositoryMockRecorder) GetByUser(userId, unread, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUser", reflect.TypeOf((*MockRepository)(nil).GetByUser), userId, unread, before, limit)
+}
+
+// GetClassStudentIds mocks base method.
+func (m *MockRepository) GetClassStudentIds(classId uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClassStudentIds", classId)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClassStudentIds indicates an expected call of GetClassStudentIds.
+func (mr *MockRepositoryMockRecorder) GetClassStudentIds(classId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClassStudentIds", reflect.TypeOf((*MockRepository)(nil).GetClassStudentIds), classId)
+}
+
+// GetDeviceTokens mocks base method.
+func (m *MockRepository) GetDeviceTokens(userIds []uint) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceTokens", userIds)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceTokens indicates an expected call of GetDeviceTokens.
+func (mr *MockRepositoryMockRecorder) GetDeviceTokens(userIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceTokens", reflect.TypeOf((*MockRepository)(nil).GetDeviceTokens), userIds)
+}
+
+// GetDevices mocks base method.
+func (m *MockRepository) GetDevices(userId uint) (*[]models.PushDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevices", userId)
+	ret0, _ := ret[0].(*[]models.PushDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevices indicates an expected call of GetDevices.
+func (mr *MockRepositoryMockRecorder) GetDevices(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevices", reflect.TypeOf((*MockRepository)(nil).GetDevices), userId)
+}
+
+// GetDigestItems mocks base method.
+func (m *MockRepository) GetDigestItems(userId uint, frequency models.EmailFrequency) ([]models.NotificationDigestItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigestItems", userId, frequency)
+	ret0, _ := ret[0].([]models.NotificationDigestItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDigestItems indicates an expected call of GetDigestItems.
+func (mr *MockRepositoryMockRecorder) GetDigestItems(userId, frequency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigestItems", reflect.TypeOf((*MockRepository)(nil).GetDigestItems), userId, frequency)
+}
+
+// GetDueDigestUserIds mocks base method.
+func (m *MockRepository) GetDueDigestUserIds(frequency models.EmailFrequency, before time.Time) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueDigestUserIds", frequency, before)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueDigestUserIds indicates an expected call of GetDueDigestUserIds.
+func (mr *MockRepositoryMockRecorder) GetDueDigestUserIds(frequency, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueDigestUserIds", reflect.TypeOf((*MockRepository)(nil).GetDueDigestUserIds), frequency, before)
+}
+
+// GetPreferences mocks base method.
+func (m *MockRepository) GetPreferences(userIds []uint, notificationType models.NotificationType) ([]models.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferences", userIds, notificationType)
+	ret0, _ := ret[0].([]models.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreferences indicates an expected call of GetPreferences.
+func (mr *MockRepositoryMockRecorder) GetPreferences(userIds, notificationType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferences", reflect.TypeOf((*MockRepository)(nil).GetPreferences), userIds, notificationType)
+}
+
+// GetRecipients mocks base method.
+func (m *MockRepository) GetRecipients(userIds []uint) ([]models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecipients", userIds)
+	ret0, _ := ret[0].([]models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecipients indicates an expected call of GetRecipients.
+func (mr *MockRepositoryMockRecorder) GetRecipients(userIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipients", reflect.TypeOf((*MockRepository)(nil).GetRecipients), userIds)
+}
+
+// GetSchoolUserIds mocks base method.
+func (m *MockRepository) GetSchoolUserIds(schoolId uint) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchoolUserIds", schoolId)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchoolUserIds indicates an expected call of GetSchoolUserIds.
+func (mr *MockRepositoryMockRecorder) GetSchoolUserIds(schoolId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchoolUserIds", reflect.TypeOf((*MockRepository)(nil).GetSchoolUserIds), schoolId)
+}
+
+// GetUserPreferences mocks base method.
+func (m *MockRepository) GetUserPreferences(userId uint) ([]models.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPreferences", userId)
+	ret0, _ := ret[0].([]models.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPreferences indicates an expected call of GetUserPreferences.
+func (mr *MockRepositoryMockRecorder) GetUserPreferences(userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPreferences", reflect.TypeOf((*MockRepository)(nil).GetUserPreferences), userId)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockRepository) MarkAllRead(userId uint, readAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", userId, readAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockRepositoryMockRecorder) MarkAllRead(userId, readAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockRepository)(nil).MarkAllRead), userId, readAt)
+}
+
+// MarkRead mocks base method.
+func (m *MockRepository) MarkRead(notification *models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockRepositoryMockRecorder) MarkRead(notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockRepository)(nil).MarkRead), notification)
+}
+
+// SaveDeliveries mocks base method.
+func (m *MockRepository) SaveDeliveries(notifications []models.Notification, emails []models.Email, digestItems []models.NotificationDigestItem) ([]models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDeliveries", notifications, emails, digestItems)
+	ret0, _ := ret[0].([]models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveDeliveries indicates an expected call of SaveDeliveries.
+func (mr *MockRepositoryMockRecorder) SaveDeliveries(notifications, emails, digestItems any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDeliveries", reflect.TypeOf((*MockRepository)(nil).SaveDeliveries), notifications, emails, digestItems)
+}
+
+// SaveDevice mocks base method.
+func (m *MockRepository) SaveDevice(device *models.PushDevice) (*models.PushDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDevice", device)
+	ret0, _ := ret[0].(*models.PushDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveDevice indicates an expected call of SaveDevice.
+func (mr *MockRepositoryMockRecorder) SaveDevice(device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDevice", reflect.TypeOf((*MockRepository)(nil).SaveDevice), device)
+}
+
+// SaveDigest mocks base method.
+func (m *MockRepository) SaveDigest(email *models.Email, itemIds []uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDigest", email, itemIds)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveDigest indicates an expected call of SaveDigest.
+func (mr *MockRepositoryMockRecorder) SaveDigest(email, itemIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDigest", reflect.TypeOf((*MockRepository)(nil).SaveDigest), email, itemIds)
+}
+
+// SavePreference mocks base method.
+func (m *MockRepository) SavePreference(preference *models.NotificationPreference) (*models.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePreference", preference)
+	ret0, _ := ret[0].(*models.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SavePreference indicates an expected call of SavePreference.
+func (mr *MockRepositoryMockRecorder) SavePreference(preference any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePreference", reflect.TypeOf((*MockRepository)(nil).SavePreference), preference)
+}
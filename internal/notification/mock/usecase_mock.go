@@ -0,0 +1,228 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/notification/usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/notification/usecase.go -destination=internal/notification/mock/usecase_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	models "github.com/esgi-challenge/backend/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetAll mocks base method.
+func (m *MockUseCase) GetAll(user *models.User, unread bool, before *uint, limit int) (*models.NotificationPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", user, unread, before, limit)
+	ret0, _ := ret[0].(*models.NotificationPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockUseCaseMockRecorder) GetAll(user, unread, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockUseCase)(nil).GetAll), user, unread, before, limit)
+}
+
+// GetDevices mocks base method.
+func (m *MockUseCase) GetDevices(user *models.User) (*[]models.PushDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevices", user)
+	ret0, _ := ret[0].(*[]models.PushDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevices indicates an expected call of GetDevices.
+func (mr *MockUseCaseMockRecorder) GetDevices(user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevices", reflect.TypeOf((*MockUseCase)(nil).GetDevices), user)
+}
+
+// GetPreferences mocks base method.
+func (m *MockUseCase) GetPreferences(user *models.User) ([]models.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferences", user)
+	ret0, _ := ret[0].([]models.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreferences indicates an expected call of GetPreferences.
+func (mr *MockUseCaseMockRecorder) GetPreferences(user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferences", reflect.TypeOf((*MockUseCase)(nil).GetPreferences), user)
+}
+
+// MarkAllRead mocks base method.
+func (m *MockUseCase) MarkAllRead(user *models.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllRead", user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllRead indicates an expected call of MarkAllRead.
+func (mr *MockUseCaseMockRecorder) MarkAllRead(user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllRead", reflect.TypeOf((*MockUseCase)(nil).MarkAllRead), user)
+}
+
+// MarkRead mocks base method.
+func (m *MockUseCase) MarkRead(user *models.User, id uint) (*models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", user, id)
+	ret0, _ := ret[0].(*models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockUseCaseMockRecorder) MarkRead(user, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockUseCase)(nil).MarkRead), user, id)
+}
+
+// Notify mocks base method.
+func (m *MockUseCase) Notify(userIds []uint, notification *models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", userIds, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockUseCaseMockRecorder) Notify(userIds, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockUseCase)(nil).Notify), userIds, notification)
+}
+
+// NotifyChatMessage mocks base method.
+func (m *MockUseCase) NotifyChatMessage(userIds []uint, message *models.Notification, data map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyChatMessage", userIds, message, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyChatMessage indicates an expected call of NotifyChatMessage.
+func (mr *MockUseCaseMockRecorder) NotifyChatMessage(userIds, message, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyChatMessage", reflect.TypeOf((*MockUseCase)(nil).NotifyChatMessage), userIds, message, data)
+}
+
+// NotifyClass mocks base method.
+func (m *MockUseCase) NotifyClass(classId uint, notification *models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyClass", classId, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyClass indicates an expected call of NotifyClass.
+func (mr *MockUseCaseMockRecorder) NotifyClass(classId, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyClass", reflect.TypeOf((*MockUseCase)(nil).NotifyClass), classId, notification)
+}
+
+// NotifySchool mocks base method.
+func (m *MockUseCase) NotifySchool(schoolId uint, notification *models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifySchool", schoolId, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifySchool indicates an expected call of NotifySchool.
+func (mr *MockUseCaseMockRecorder) NotifySchool(schoolId, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifySchool", reflect.TypeOf((*MockUseCase)(nil).NotifySchool), schoolId, notification)
+}
+
+// RegisterDevice mocks base method.
+func (m *MockUseCase) RegisterDevice(user *models.User, device *models.PushDeviceCreate) (*models.PushDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDevice", user, device)
+	ret0, _ := ret[0].(*models.PushDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterDevice indicates an expected call of RegisterDevice.
+func (mr *MockUseCaseMockRecorder) RegisterDevice(user, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDevice", reflect.TypeOf((*MockUseCase)(nil).RegisterDevice), user, device)
+}
+
+// SendDigests mocks base method.
+func (m *MockUseCase) SendDigests() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendDigests")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendDigests indicates an expected call of SendDigests.
+func (mr *MockUseCaseMockRecorder) SendDigests() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDigests", reflect.TypeOf((*MockUseCase)(nil).SendDigests))
+}
+
+// UnregisterDevice mocks base method.
+func (m *MockUseCase) UnregisterDevice(user *models.User, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnregisterDevice", user, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnregisterDevice indicates an expected call of UnregisterDevice.
+func (mr *MockUseCaseMockRecorder) UnregisterDevice(user, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterDevice", reflect.TypeOf((*MockUseCase)(nil).UnregisterDevice), user, token)
+}
+
+// UpdatePreference mocks base method.
+func (m *MockUseCase) UpdatePreference(user *models.User, notificationType models.NotificationType, update *models.NotificationPreferenceUpdate) (*models.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePreference", user, notificationType, update)
+	ret0, _ := ret[0].(*models.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePreference indicates an expected call of UpdatePreference.
+func (mr *MockUseCaseMockRecorder) UpdatePreference(user, notificationType, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePreference", reflect.TypeOf((*MockUseCase)(nil).UpdatePreference), user, notificationType, update)
+}
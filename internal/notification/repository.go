@@ -0,0 +1,34 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+)
+
+type Repository interface {
+	SaveDeliveries(notifications []models.Notification, emails []models.Email, digestItems []models.NotificationDigestItem) ([]models.Notification, error)
+	GetById(id uint) (*models.Notification, error)
+	GetByUser(userId uint, unread bool, before uint, limit int) (*[]models.Notification, error)
+	CountUnread(userId uint) (int64, error)
+	MarkRead(notification *models.Notification) error
+	MarkAllRead(userId uint, readAt time.Time) error
+	GetClassStudentIds(classId uint) ([]uint, error)
+	GetSchoolUserIds(schoolId uint) ([]uint, error)
+	// Only the id, email and locale of the users
+	GetRecipients(userIds []uint) ([]models.User, error)
+	GetPreferences(userIds []uint, notificationType models.NotificationType) ([]models.NotificationPreference, error)
+	GetUserPreferences(userId uint) ([]models.NotificationPreference, error)
+	SavePreference(preference *models.NotificationPreference) (*models.NotificationPreference, error)
+	// Users with a digest item older than before
+	GetDueDigestUserIds(frequency models.EmailFrequency, before time.Time) ([]uint, error)
+	GetDigestItems(userId uint, frequency models.EmailFrequency) ([]models.NotificationDigestItem, error)
+	// False when the items were already sent by another instance
+	SaveDigest(email *models.Email, itemIds []uint) (bool, error)
+	// Registering a known token moves it to the user
+	SaveDevice(device *models.PushDevice) (*models.PushDevice, error)
+	GetDevices(userId uint) (*[]models.PushDevice, error)
+	GetDeviceTokens(userIds []uint) ([]string, error)
+	DeleteDevice(userId uint, token string) error
+	DeleteDevicesByTokens(tokens []string) error
+}
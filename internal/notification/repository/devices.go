@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"github.com/esgi-challenge/backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *notificationRepo) SaveDevice(device *models.PushDevice) (*models.PushDevice, error) {
+	err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "token"}},
+		DoUpdates: clause.AssignmentColumns([]string{"user_id", "platform", "updated_at"}),
+	}).Create(device).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return device, nil
+}
+
+func (r *notificationRepo) GetDevices(userId uint) (*[]models.PushDevice, error) {
+	var devices []models.PushDevice
+
+	if err := r.db.Where("user_id = ?", userId).Order("id DESC").Find(&devices).Error; err != nil {
+		return nil, err
+	}
+
+	return &devices, nil
+}
+
+func (r *notificationRepo) GetDeviceTokens(userIds []uint) ([]string, error) {
+	var tokens []string
+
+	if len(userIds) == 0 {
+		return tokens, nil
+	}
+
+	if err := r.db.Model(&models.PushDevice{}).Where("user_id IN ?", userIds).Pluck("token", &tokens).Error; err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// Devices are deleted for good for their token to be registered again
+func (r *notificationRepo) DeleteDevice(userId uint, token string) error {
+	result := r.db.Unscoped().Where("user_id = ? AND token = ?", userId, token).Delete(&models.PushDevice{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *notificationRepo) DeleteDevicesByTokens(tokens []string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	return r.db.Unscoped().Where("token IN ?", tokens).Delete(&models.PushDevice{}).Error
+}
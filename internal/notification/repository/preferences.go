@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// The digest was saved by another instance, its items are gone
+var errDigestTaken = errors.New("digest already sent")
+
+func (r *notificationRepo) GetPreferences(userIds []uint, notificationType models.NotificationType) ([]models.NotificationPreference, error) {
+	var preferences []models.NotificationPreference
+
+	if len(userIds) == 0 {
+		return preferences, nil
+	}
+
+	if err := r.db.Where("user_id IN ? AND type = ?", userIds, notificationType).Find(&preferences).Error; err != nil {
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+func (r *notificationRepo) GetUserPreferences(userId uint) ([]models.NotificationPreference, error) {
+	var preferences []models.NotificationPreference
+
+	if err := r.db.Where("user_id = ?", userId).Find(&preferences).Error; err != nil {
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+func (r *notificationRepo) SavePreference(preference *models.NotificationPreference) (*models.NotificationPreference, error) {
+	err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"in_app", "push", "email", "updated_at"}),
+	}).Create(preference).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return preference, nil
+}
+
+func (r *notificationRepo) GetDueDigestUserIds(frequency models.EmailFrequency, before time.Time) ([]uint, error) {
+	var ids []uint
+
+	err := r.db.Model(&models.NotificationDigestItem{}).
+		Where("frequency = ?", frequency).
+		Group("user_id").
+		Having("MIN(created_at) <= ?", before).
+		Pluck("user_id", &ids).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *notificationRepo) GetDigestItems(userId uint, frequency models.EmailFrequency) ([]models.NotificationDigestItem, error) {
+	var items []models.NotificationDigestItem
+
+	if err := r.db.Where("user_id = ? AND frequency = ?", userId, frequency).Order("id").Find(&items).Error; err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// The items are deleted with the email queued, an instance deleting fewer items than it
+// read lost the race and rolls back
+func (r *notificationRepo) SaveDigest(email *models.Email, itemIds []uint) (bool, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("id IN ?", itemIds).Delete(&models.NotificationDigestItem{})
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected != int64(len(itemIds)) {
+			return errDigestTaken
+		}
+
+		return tx.Create(email).Error
+	})
+
+	if errors.Is(err, errDigestTaken) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
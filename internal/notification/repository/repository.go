@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/notification"
+	"gorm.io/gorm"
+)
+
+type notificationRepo struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) notification.Repository {
+	return &notificationRepo{db: db}
+}
+
+// The in-app notifications, the instant emails and the digest items of an event are saved together
+func (r *notificationRepo) SaveDeliveries(notifications []models.Notification, emails []models.Email, digestItems []models.NotificationDigestItem) ([]models.Notification, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if len(notifications) > 0 {
+			if err := tx.Create(&notifications).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(emails) > 0 {
+			if err := tx.Create(&emails).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(digestItems) > 0 {
+			if err := tx.Create(&digestItems).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (r *notificationRepo) GetById(id uint) (*models.Notification, error) {
+	var notification models.Notification
+
+	if err := r.db.First(&notification, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &notification, nil
+}
+
+func (r *notificationRepo) GetByUser(userId uint, unread bool, before uint, limit int) (*[]models.Notification, error) {
+	var notifications []models.Notification
+
+	query := r.db.Where("user_id = ?", userId)
+
+	if unread {
+		query = query.Where("read_at IS NULL")
+	}
+
+	if before != 0 {
+		query = query.Where("id < ?", before)
+	}
+
+	if err := query.Order("id DESC").Limit(limit).Find(&notifications).Error; err != nil {
+		return nil, err
+	}
+
+	return &notifications, nil
+}
+
+func (r *notificationRepo) CountUnread(userId uint) (int64, error) {
+	var count int64
+
+	if err := r.db.Model(&models.Notification{}).Where("user_id = ? AND read_at IS NULL", userId).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (r *notificationRepo) MarkRead(notification *models.Notification) error {
+	return r.db.Model(notification).Select("read_at").Updates(notification).Error
+}
+
+func (r *notificationRepo) MarkAllRead(userId uint, readAt time.Time) error {
+	return r.db.Model(&models.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userId).
+		Update("read_at", readAt).Error
+}
+
+func (r *notificationRepo) GetClassStudentIds(classId uint) ([]uint, error) {
+	var ids []uint
+
+	if err := r.db.Model(&models.User{}).Where("class_refer = ?", classId).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *notificationRepo) GetSchoolUserIds(schoolId uint) ([]uint, error) {
+	var ids []uint
+
+	if err := r.db.Model(&models.User{}).Where("school_id = ?", schoolId).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *notificationRepo) GetRecipients(userIds []uint) ([]models.User, error) {
+	var users []models.User
+
+	if len(userIds) == 0 {
+		return users, nil
+	}
+
+	if err := r.db.Select("id", "email", "locale").Where("id IN ?", userIds).Find(&users).Error; err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
@@ -0,0 +1,24 @@
+package notification
+
+import (
+	"github.com/esgi-challenge/backend/internal/models"
+)
+
+type UseCase interface {
+	Notify(userIds []uint, notification *models.Notification) error
+	NotifyClass(classId uint, notification *models.Notification) error
+	NotifySchool(schoolId uint, notification *models.Notification) error
+	GetAll(user *models.User, unread bool, before *uint, limit int) (*models.NotificationPage, error)
+	MarkRead(user *models.User, id uint) (*models.Notification, error)
+	MarkAllRead(user *models.User) error
+	RegisterDevice(user *models.User, device *models.PushDeviceCreate) (*models.PushDevice, error)
+	GetDevices(user *models.User) (*[]models.PushDevice, error)
+	UnregisterDevice(user *models.User, token string) error
+	// Push, email or digest for the members without an open socket, as they chose
+	// Only the message key and params of the notification are used, data is given to the app
+	NotifyChatMessage(userIds []uint, message *models.Notification, data map[string]string) error
+	GetPreferences(user *models.User) ([]models.NotificationPreference, error)
+	UpdatePreference(user *models.User, notificationType models.NotificationType, update *models.NotificationPreferenceUpdate) (*models.NotificationPreference, error)
+	// Queue the digest emails of the users whose oldest pending item is a day or a week old
+	SendDigests() error
+}
@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/email"
+)
+
+// A digest is sent once its oldest item waited the whole period, the job runs hourly so
+// an item waits an hour more at most
+var digestPeriods = []struct {
+	frequency models.EmailFrequency
+	period    time.Duration
+}{
+	{models.EMAIL_DAILY, 24 * time.Hour},
+	{models.EMAIL_WEEKLY, 7 * 24 * time.Hour},
+}
+
+func (u *notificationUseCase) SendDigests() error {
+	now := time.Now()
+
+	for _, digest := range digestPeriods {
+		userIds, err := u.notificationRepo.GetDueDigestUserIds(digest.frequency, now.Add(-digest.period))
+		if err != nil {
+			return err
+		}
+
+		recipients, err := u.notificationRepo.GetRecipients(userIds)
+		if err != nil {
+			return err
+		}
+
+		for i := range recipients {
+			if err := u.sendDigest(&recipients[i], digest.frequency); err != nil {
+				u.logger.Errorf("Notification: user %d %s digest: %v", recipients[i].ID, digest.frequency, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (u *notificationUseCase) sendDigest(recipient *models.User, frequency models.EmailFrequency) error {
+	items, err := u.notificationRepo.GetDigestItems(recipient.ID, frequency)
+	if err != nil {
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	entries := make([]email.DigestEntry, 0, len(items))
+	itemIds := make([]uint, 0, len(items))
+
+	for _, item := range items {
+		title, body := item.Title, item.Body
+
+		// Rendered in the locale the user has now
+		if item.MessageKey != "" {
+			title, body, err = email.RenderNotification(recipient.Locale, item.MessageKey, item.MessageParams)
+			if err != nil {
+				return err
+			}
+		}
+
+		entries = append(entries, email.DigestEntry{Title: title, Body: body})
+		itemIds = append(itemIds, item.ID)
+	}
+
+	digest, err := email.DigestEmail(recipient.Email, recipient.Locale, frequency, entries)
+	if err != nil {
+		return err
+	}
+
+	sent, err := u.notificationRepo.SaveDigest(digest, itemIds)
+	if err != nil {
+		return err
+	}
+
+	if !sent {
+		u.logger.Infof("Notification: user %d %s digest already sent", recipient.ID, frequency)
+	}
+
+	return nil
+}
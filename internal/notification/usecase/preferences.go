@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/email"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+)
+
+// Preferences of the users for the type, the default one for users who never changed it
+func (u *notificationUseCase) preferences(userIds []uint, notificationType models.NotificationType) (map[uint]models.NotificationPreference, error) {
+	saved, err := u.notificationRepo.GetPreferences(userIds, notificationType)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences := make(map[uint]models.NotificationPreference, len(userIds))
+
+	for _, id := range userIds {
+		preferences[id] = models.DefaultNotificationPreference(id, notificationType)
+	}
+
+	for _, preference := range saved {
+		preferences[preference.UserId] = preference
+	}
+
+	return preferences, nil
+}
+
+// Instant emails and digest items of the users who want the event by email, in their locale
+func (u *notificationUseCase) emails(recipients []models.User, preferences map[uint]models.NotificationPreference, notificationType models.NotificationType, texts *localizedTexts) ([]models.Email, []models.NotificationDigestItem, error) {
+	var emails []models.Email
+	var digestItems []models.NotificationDigestItem
+
+	for _, recipient := range recipients {
+		frequency := preferences[recipient.ID].Email
+
+		if frequency == models.EMAIL_NEVER {
+			continue
+		}
+
+		title, body, err := texts.render(recipient.Locale)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch frequency {
+		case models.EMAIL_INSTANT:
+			message, err := email.NotificationEmail(recipient.Email, recipient.Locale, title, body)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			emails = append(emails, *message)
+		case models.EMAIL_DAILY, models.EMAIL_WEEKLY:
+			digestItems = append(digestItems, models.NotificationDigestItem{
+				UserId:        recipient.ID,
+				Frequency:     frequency,
+				Type:          notificationType,
+				MessageKey:    texts.key,
+				MessageParams: texts.params,
+				Title:         title,
+				Body:          body,
+			})
+		}
+	}
+
+	return emails, digestItems, nil
+}
+
+// Every type, in the order of models.NotificationTypes
+func (u *notificationUseCase) GetPreferences(user *models.User) ([]models.NotificationPreference, error) {
+	saved, err := u.notificationRepo.GetUserPreferences(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences := make([]models.NotificationPreference, 0, len(models.NotificationTypes))
+
+	for _, notificationType := range models.NotificationTypes {
+		preference := models.DefaultNotificationPreference(user.ID, notificationType)
+
+		for _, savedPreference := range saved {
+			if savedPreference.Type == notificationType {
+				preference = savedPreference
+			}
+		}
+
+		preferences = append(preferences, preference)
+	}
+
+	return preferences, nil
+}
+
+func (u *notificationUseCase) UpdatePreference(user *models.User, notificationType models.NotificationType, update *models.NotificationPreferenceUpdate) (*models.NotificationPreference, error) {
+	if !slices.Contains(models.NotificationTypes, notificationType) {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusNotFound,
+			HttpError:  "Unknown notification type",
+		}
+	}
+
+	return u.notificationRepo.SavePreference(&models.NotificationPreference{
+		UserId: user.ID,
+		Type:   notificationType,
+		InApp:  *update.InApp,
+		Push:   *update.Push,
+		Email:  update.Email,
+	})
+}
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/push"
+)
+
+const pushTimeout = 30 * time.Second
+
+func (u *notificationUseCase) RegisterDevice(user *models.User, device *models.PushDeviceCreate) (*models.PushDevice, error) {
+	return u.notificationRepo.SaveDevice(&models.PushDevice{
+		UserId:   user.ID,
+		Token:    device.Token,
+		Platform: device.Platform,
+	})
+}
+
+func (u *notificationUseCase) GetDevices(user *models.User) (*[]models.PushDevice, error) {
+	return u.notificationRepo.GetDevices(user.ID)
+}
+
+func (u *notificationUseCase) UnregisterDevice(user *models.User, token string) error {
+	return u.notificationRepo.DeleteDevice(user.ID, token)
+}
+
+// Users with an open socket on any instance already got the event
+func (u *notificationUseCase) offline(userIds []uint) []uint {
+	online := u.presence.OnlineUsers(userIds)
+	var offline []uint
+
+	for _, id := range userIds {
+		if !online[id] {
+			offline = append(offline, id)
+		}
+	}
+
+	return offline
+}
+
+func (u *notificationUseCase) offlineRecipients(recipients []models.User) []models.User {
+	userIds := []uint{}
+	for _, recipient := range recipients {
+		userIds = append(userIds, recipient.ID)
+	}
+
+	online := u.presence.OnlineUsers(userIds)
+	var offline []models.User
+
+	for _, recipient := range recipients {
+		if !online[recipient.ID] {
+			offline = append(offline, recipient)
+		}
+	}
+
+	return offline
+}
+
+func (u *notificationUseCase) pushInBackground(userIds []uint, message *push.Message) {
+	if len(userIds) == 0 {
+		return
+	}
+
+	go func() {
+		if err := u.push(userIds, message); err != nil {
+			u.logger.Errorf("Push: %v", err)
+		}
+	}()
+}
+
+func (u *notificationUseCase) push(userIds []uint, message *push.Message) error {
+	tokens, err := u.notificationRepo.GetDeviceTokens(userIds)
+	if err != nil {
+		return err
+	}
+
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pushTimeout)
+	defer cancel()
+
+	result, err := u.pushProvider.Send(ctx, tokens, message)
+
+	// Some messages may have failed while others found invalid tokens
+	if result != nil && len(result.InvalidTokens) > 0 {
+		if err := u.notificationRepo.DeleteDevicesByTokens(result.InvalidTokens); err != nil {
+			u.logger.Errorf("Push: invalid tokens: %v", err)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/email"
+	"github.com/esgi-challenge/backend/pkg/push"
+)
+
+// Title and body of a notification, rendered once per locale of its recipients
+type localizedTexts struct {
+	key    models.NotificationMessageKey
+	params map[string]string
+	texts  map[models.Locale][2]string
+}
+
+func newLocalizedTexts(key models.NotificationMessageKey, params map[string]string) *localizedTexts {
+	return &localizedTexts{key: key, params: params, texts: map[models.Locale][2]string{}}
+}
+
+func (t *localizedTexts) render(locale models.Locale) (string, string, error) {
+	if text, ok := t.texts[locale]; ok {
+		return text[0], text[1], nil
+	}
+
+	title, body, err := email.RenderNotification(locale, t.key, t.params)
+	if err != nil {
+		return "", "", err
+	}
+
+	t.texts[locale] = [2]string{title, body}
+
+	return title, body, nil
+}
+
+// One push message per locale of the recipients
+func (u *notificationUseCase) pushByLocale(recipients []models.User, texts *localizedTexts, data map[string]string) error {
+	idsByLocale := map[models.Locale][]uint{}
+
+	for _, recipient := range recipients {
+		idsByLocale[recipient.Locale] = append(idsByLocale[recipient.Locale], recipient.ID)
+	}
+
+	for locale, ids := range idsByLocale {
+		title, body, err := texts.render(locale)
+		if err != nil {
+			return err
+		}
+
+		u.pushInBackground(ids, &push.Message{
+			Title: title,
+			Body:  body,
+			Data:  data,
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/notification"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/esgi-challenge/backend/pkg/push"
+	"github.com/esgi-challenge/backend/pkg/realtime"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 100
+)
+
+type notificationUseCase struct {
+	notificationRepo notification.Repository
+	hub              realtime.Hub
+	presence         *realtime.Presence
+	pushProvider     push.Provider
+	cfg              *config.Config
+	logger           logger.Logger
+}
+
+func NewNotificationUseCase(cfg *config.Config, notificationRepo notification.Repository, hub realtime.Hub, presence *realtime.Presence, pushProvider push.Provider, logger logger.Logger) notification.UseCase {
+	return &notificationUseCase{
+		cfg:              cfg,
+		notificationRepo: notificationRepo,
+		hub:              hub,
+		presence:         presence,
+		pushProvider:     pushProvider,
+		logger:           logger,
+	}
+}
+
+func (u *notificationUseCase) publish(userId uint, event *models.NotificationEvent) {
+	payload, err := json.Marshal(event)
+
+	if err != nil {
+		u.logger.Errorf("Notification: user %d event: %v", userId, err)
+		return
+	}
+
+	u.hub.Publish(models.NotificationUserTopic(userId), payload)
+}
+
+// Store a copy of the notification for the users who want it in-app and send it to their
+// sockets, the others get it by push, email or in their next digest as they chose. Every
+// user gets it in their locale
+func (u *notificationUseCase) Notify(userIds []uint, notification *models.Notification) error {
+	ids := slices.Clone(userIds)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+	ids = slices.DeleteFunc(ids, func(id uint) bool { return id == 0 })
+
+	preferences, err := u.preferences(ids, notification.Type)
+	if err != nil {
+		return err
+	}
+
+	recipients, err := u.notificationRepo.GetRecipients(ids)
+	if err != nil {
+		return err
+	}
+
+	texts := newLocalizedTexts(notification.MessageKey, notification.MessageParams)
+
+	notifications := []models.Notification{}
+	pushRecipients := []models.User{}
+
+	for _, recipient := range recipients {
+		if preferences[recipient.ID].InApp {
+			title, body, err := texts.render(recipient.Locale)
+			if err != nil {
+				return err
+			}
+
+			notifications = append(notifications, models.Notification{
+				UserId:        recipient.ID,
+				Type:          notification.Type,
+				MessageKey:    notification.MessageKey,
+				MessageParams: notification.MessageParams,
+				Title:         title,
+				Body:          body,
+				RefId:         notification.RefId,
+			})
+		}
+
+		if preferences[recipient.ID].Push {
+			pushRecipients = append(pushRecipients, recipient)
+		}
+	}
+
+	emails, digestItems, err := u.emails(recipients, preferences, notification.Type, texts)
+	if err != nil {
+		return err
+	}
+
+	notifications, err = u.notificationRepo.SaveDeliveries(notifications, emails, digestItems)
+	if err != nil {
+		return err
+	}
+
+	for i := range notifications {
+		u.publish(notifications[i].UserId, &models.NotificationEvent{
+			Type:         models.NOTIFICATION_EVENT_NEW,
+			Notification: &notifications[i],
+		})
+	}
+
+	data := map[string]string{
+		"type":             "notification",
+		"notificationType": string(notification.Type),
+	}
+	if notification.RefId != nil {
+		data["refId"] = strconv.FormatUint(uint64(*notification.RefId), 10)
+	}
+
+	return u.pushByLocale(u.offlineRecipients(pushRecipients), texts, data)
+}
+
+// Chat messages already reach the open sockets of the channel, the offline members get
+// them by push, email or in their next digest
+func (u *notificationUseCase) NotifyChatMessage(userIds []uint, message *models.Notification, data map[string]string) error {
+	ids := u.offline(userIds)
+
+	preferences, err := u.preferences(ids, models.NOTIFICATION_CHAT)
+	if err != nil {
+		return err
+	}
+
+	recipients, err := u.notificationRepo.GetRecipients(ids)
+	if err != nil {
+		return err
+	}
+
+	texts := newLocalizedTexts(message.MessageKey, message.MessageParams)
+
+	emails, digestItems, err := u.emails(recipients, preferences, models.NOTIFICATION_CHAT, texts)
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.notificationRepo.SaveDeliveries(nil, emails, digestItems); err != nil {
+		return err
+	}
+
+	pushRecipients := slices.DeleteFunc(recipients, func(recipient models.User) bool { return !preferences[recipient.ID].Push })
+
+	return u.pushByLocale(pushRecipients, texts, data)
+}
+
+func (u *notificationUseCase) NotifyClass(classId uint, notification *models.Notification) error {
+	ids, err := u.notificationRepo.GetClassStudentIds(classId)
+	if err != nil {
+		return err
+	}
+
+	return u.Notify(ids, notification)
+}
+
+func (u *notificationUseCase) NotifySchool(schoolId uint, notification *models.Notification) error {
+	ids, err := u.notificationRepo.GetSchoolUserIds(schoolId)
+	if err != nil {
+		return err
+	}
+
+	return u.Notify(ids, notification)
+}
+
+func (u *notificationUseCase) GetAll(user *models.User, unread bool, before *uint, limit int) (*models.NotificationPage, error) {
+	if limit <= 0 {
+		limit = defaultPageLimit
+	}
+	limit = min(limit, maxPageLimit)
+
+	var beforeId uint
+	if before != nil {
+		beforeId = *before
+	}
+
+	// One more notification to know if there is a next page
+	notifications, err := u.notificationRepo.GetByUser(user.ID, unread, beforeId, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := u.notificationRepo.CountUnread(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.NotificationPage{Notifications: *notifications, Unread: count}
+
+	if len(page.Notifications) > limit {
+		page.Notifications = page.Notifications[:limit]
+		cursor := page.Notifications[limit-1].ID
+		page.NextCursor = &cursor
+	}
+
+	return page, nil
+}
+
+func (u *notificationUseCase) MarkRead(user *models.User, id uint) (*models.Notification, error) {
+	notification, err := u.notificationRepo.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if notification.UserId != user.ID {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "This notification is not yours",
+		}
+	}
+
+	// Reading twice keeps the first read date
+	if notification.ReadAt != nil {
+		return notification, nil
+	}
+
+	now := time.Now()
+	notification.ReadAt = &now
+
+	if err := u.notificationRepo.MarkRead(notification); err != nil {
+		return nil, err
+	}
+
+	u.publishRead(user.ID, notification.ID)
+
+	return notification, nil
+}
+
+func (u *notificationUseCase) MarkAllRead(user *models.User) error {
+	if err := u.notificationRepo.MarkAllRead(user.ID, time.Now()); err != nil {
+		return err
+	}
+
+	u.publishRead(user.ID, 0)
+
+	return nil
+}
+
+// The other sockets of the user update their unread count, notificationId is 0 when all were read
+func (u *notificationUseCase) publishRead(userId uint, notificationId uint) {
+	event := &models.NotificationEvent{
+		Type:           models.NOTIFICATION_EVENT_READ,
+		NotificationId: notificationId,
+	}
+
+	if notificationId == 0 {
+		event.Type = models.NOTIFICATION_EVENT_READ_ALL
+	}
+
+	count, err := u.notificationRepo.CountUnread(userId)
+	if err != nil {
+		u.logger.Errorf("Notification: user %d unread count: %v", userId, err)
+		return
+	}
+	event.Unread = &count
+
+	u.publish(userId, event)
+}
@@ -0,0 +1,10 @@
+package outbox
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+type Handlers interface {
+	GetDead() gin.HandlerFunc
+	Retry() gin.HandlerFunc
+}
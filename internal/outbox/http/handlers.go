@@ -0,0 +1,96 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/outbox"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/esgi-challenge/backend/pkg/request"
+	"github.com/gin-gonic/gin"
+)
+
+type outboxHandlers struct {
+	cfg           *config.Config
+	outboxUseCase outbox.UseCase
+	logger        logger.Logger
+}
+
+func NewOutboxHandlers(cfg *config.Config, outboxUseCase outbox.UseCase, logger logger.Logger) outbox.Handlers {
+	return &outboxHandlers{cfg: cfg, outboxUseCase: outboxUseCase, logger: logger}
+}
+
+// Read
+//
+//	@Summary		Get dead emails
+//	@Description	Get the emails of the outbox that failed too many times to be sent, newest first
+//	@Tags			Outbox
+//	@Produce		json
+//	@Success		200	{object}	[]models.Email
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/outbox/dead [get]
+func (u *outboxHandlers) GetDead() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.SUPERADMIN)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		emails, err := u.outboxUseCase.GetDead()
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, emails)
+	}
+}
+
+// Update
+//
+//	@Summary		Retry dead email
+//	@Description	Send the dead email again with a new set of attempts
+//	@Tags			Outbox
+//	@Produce		json
+//	@Param			id	path		int	true	"id"
+//	@Success		200	{object}	models.Email
+//	@Failure		400	{object}	errorHandler.HttpErr
+//	@Failure		404	{object}	errorHandler.HttpErr
+//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Router			/outbox/{id}/retry [post]
+func (u *outboxHandlers) Retry() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		user, err := request.ValidateRole(u.cfg.JwtSecret, ctx, models.SUPERADMIN)
+
+		if user == nil || err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+			return
+		}
+
+		id := ctx.Params.ByName("id")
+		idInt, err := strconv.Atoi(id)
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		email, err := u.outboxUseCase.Retry(uint(idInt))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		ctx.JSON(http.StatusOK, email)
+	}
+}
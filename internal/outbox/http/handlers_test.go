@@ -0,0 +1,147 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/outbox/mock"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/jwt"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetDead(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewLogger()
+	logger.InitLogger()
+	mockUseCase := mock.NewMockUseCase(ctrl)
+	cfg := &config.Config{JwtSecret: "secret"}
+	handlers := NewOutboxHandlers(cfg, mockUseCase, logger)
+
+	superAdmin := &models.User{UserKind: models.NewUserKind(models.SUPERADMIN)}
+	token, _ := jwt.Generate(cfg.JwtSecret, superAdmin)
+
+	admin := &models.User{UserKind: models.NewUserKind(models.ADMINISTRATOR)}
+	adminToken, _ := jwt.Generate(cfg.JwtSecret, admin)
+
+	t.Run("GetDead request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/outbox/dead", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+
+		mockUseCase.EXPECT().GetDead().Return(&[]models.Email{{Status: models.EMAIL_DEAD}}, nil)
+
+		handler := handlers.GetDead()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("GetDead request not super admin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/outbox/dead", nil)
+		req.Header.Set("Authorization", "Bearer "+adminToken)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+
+		handler := handlers.GetDead()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusUnauthorized, res.Code)
+	})
+
+	t.Run("GetDead request server error", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/outbox/dead", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+
+		mockUseCase.EXPECT().GetDead().Return(nil, errors.New("random server error"))
+
+		handler := handlers.GetDead()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusInternalServerError, res.Code)
+	})
+}
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+	gin.SetMode(gin.TestMode)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewLogger()
+	logger.InitLogger()
+	mockUseCase := mock.NewMockUseCase(ctrl)
+	cfg := &config.Config{JwtSecret: "secret"}
+	handlers := NewOutboxHandlers(cfg, mockUseCase, logger)
+
+	superAdmin := &models.User{UserKind: models.NewUserKind(models.SUPERADMIN)}
+	token, _ := jwt.Generate(cfg.JwtSecret, superAdmin)
+
+	t.Run("Retry request", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/outbox/1/retry", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		mockUseCase.EXPECT().Retry(uint(1)).Return(&models.Email{Status: models.EMAIL_PENDING}, nil)
+
+		handler := handlers.Retry()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusOK, res.Code)
+	})
+
+	t.Run("Retry request bad id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/outbox/abc/retry", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "abc"}}
+
+		handler := handlers.Retry()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("Retry request not dead", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/api/outbox/1/retry", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		res := httptest.NewRecorder()
+		ctx, _ := gin.CreateTestContext(res)
+		ctx.Request = req
+		ctx.Params = gin.Params{{Key: "id", Value: "1"}}
+
+		mockUseCase.EXPECT().Retry(uint(1)).Return(nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "Only dead emails can be retried",
+		})
+
+		handler := handlers.Retry()
+		handler(ctx)
+
+		assert.Equal(t, http.StatusBadRequest, res.Code)
+	})
+}
@@ -0,0 +1,11 @@
+package http
+
+import (
+	"github.com/esgi-challenge/backend/internal/outbox"
+	"github.com/gin-gonic/gin"
+)
+
+func SetupOutboxRoutes(outboxGroup *gin.RouterGroup, h outbox.Handlers) {
+	outboxGroup.GET("/dead", h.GetDead())
+	outboxGroup.POST("/:id/retry", h.Retry())
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/outbox/usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/outbox/usecase.go -destination=internal/outbox/mock/usecase_mock.go -package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	models "github.com/esgi-challenge/backend/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Deliver mocks base method.
+func (m *MockUseCase) Deliver() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deliver")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deliver indicates an expected call of Deliver.
+func (mr *MockUseCaseMockRecorder) Deliver() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deliver", reflect.TypeOf((*MockUseCase)(nil).Deliver))
+}
+
+// GetDead mocks base method.
+func (m *MockUseCase) GetDead() (*[]models.Email, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDead")
+	ret0, _ := ret[0].(*[]models.Email)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDead indicates an expected call of GetDead.
+func (mr *MockUseCaseMockRecorder) GetDead() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDead", reflect.TypeOf((*MockUseCase)(nil).GetDead))
+}
+
+// Retry mocks base method.
+func (m *MockUseCase) Retry(id uint) (*models.Email, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", id)
+	ret0, _ := ret[0].(*models.Email)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockUseCaseMockRecorder) Retry(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockUseCase)(nil).Retry), id)
+}
@@ -0,0 +1,16 @@
+package outbox
+
+import (
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+)
+
+type Repository interface {
+	// Pending emails due at now, counted as attempted and hidden from the other workers until now+lease
+	Claim(now time.Time, lease time.Duration, limit int) (*[]models.Email, error)
+	GetById(id uint) (*models.Email, error)
+	GetByStatus(status models.EmailStatus) (*[]models.Email, error)
+	// Save the status, attempts, next attempt, error and sent date
+	UpdateDelivery(email *models.Email) error
+}
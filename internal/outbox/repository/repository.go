@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/outbox"
+	"gorm.io/gorm"
+)
+
+type outboxRepo struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) outbox.Repository {
+	return &outboxRepo{db: db}
+}
+
+func (r *outboxRepo) Claim(now time.Time, lease time.Duration, limit int) (*[]models.Email, error) {
+	var emails []models.Email
+
+	if err := r.db.Raw(claimQuery,
+		sql.Named("status", models.EMAIL_PENDING),
+		sql.Named("now", now),
+		sql.Named("lease", now.Add(lease)),
+		sql.Named("limit", limit),
+	).Scan(&emails).Error; err != nil {
+		return nil, err
+	}
+
+	return &emails, nil
+}
+
+func (r *outboxRepo) GetById(id uint) (*models.Email, error) {
+	var email models.Email
+
+	if err := r.db.First(&email, id).Error; err != nil {
+		return nil, err
+	}
+
+	return &email, nil
+}
+
+func (r *outboxRepo) GetByStatus(status models.EmailStatus) (*[]models.Email, error) {
+	var emails []models.Email
+
+	if err := r.db.Where("status = ?", status).Order("id DESC").Find(&emails).Error; err != nil {
+		return nil, err
+	}
+
+	return &emails, nil
+}
+
+func (r *outboxRepo) UpdateDelivery(email *models.Email) error {
+	return r.db.Model(email).
+		Select("status", "attempts", "next_attempt_at", "last_error", "sent_at").
+		Updates(email).Error
+}
@@ -0,0 +1,24 @@
+package repository
+
+const (
+	// Rows locked by another worker are skipped, the lease keeps them away once committed
+	claimQuery = `
+	UPDATE emails
+	SET
+		attempts = attempts + 1,
+		next_attempt_at = @lease,
+		updated_at = @now
+	WHERE id IN (
+		SELECT id
+		FROM emails
+		WHERE
+			deleted_at IS NULL
+			AND status = @status
+			AND next_attempt_at <= @now
+		ORDER BY next_attempt_at
+		LIMIT @limit
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING *
+	`
+)
@@ -0,0 +1,12 @@
+package outbox
+
+import (
+	"github.com/esgi-challenge/backend/internal/models"
+)
+
+type UseCase interface {
+	// Send the due emails, the failed ones are retried later
+	Deliver() error
+	GetDead() (*[]models.Email, error)
+	Retry(id uint) (*models.Email, error)
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/outbox"
+	"github.com/esgi-challenge/backend/pkg/email"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+)
+
+const (
+	// Emails claimed by a delivery run
+	batchSize = 20
+	// Time a worker has to send a claimed email before another one can claim it
+	claimLease = 5 * time.Minute
+	// The email is dead after this many failed attempts, about two hours after the first one
+	maxAttempts = 8
+	baseBackoff = time.Minute
+)
+
+type outboxUseCase struct {
+	outboxRepo outbox.Repository
+	sender     email.Sender
+	cfg        *config.Config
+	logger     logger.Logger
+}
+
+func NewOutboxUseCase(cfg *config.Config, outboxRepo outbox.Repository, sender email.Sender, logger logger.Logger) outbox.UseCase {
+	return &outboxUseCase{
+		cfg:        cfg,
+		outboxRepo: outboxRepo,
+		sender:     sender,
+		logger:     logger,
+	}
+}
+
+// Delay before the next attempt, doubled after each failure
+func backoff(attempts int) time.Duration {
+	return baseBackoff << (attempts - 1)
+}
+
+func (u *outboxUseCase) Deliver() error {
+	for {
+		emails, err := u.outboxRepo.Claim(time.Now(), claimLease, batchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range *emails {
+			u.deliver(&(*emails)[i])
+		}
+
+		if len(*emails) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (u *outboxUseCase) deliver(email *models.Email) {
+	if err := u.sender.Send(email); err != nil {
+		email.LastError = err.Error()
+
+		if email.Attempts >= maxAttempts {
+			email.Status = models.EMAIL_DEAD
+			u.logger.Errorf("Outbox: email %d is dead after %d attempts: %v", email.ID, email.Attempts, err)
+		} else {
+			email.NextAttemptAt = time.Now().Add(backoff(email.Attempts))
+			u.logger.Infof("Outbox: email %d attempt %d: %v", email.ID, email.Attempts, err)
+		}
+	} else {
+		now := time.Now()
+		email.Status = models.EMAIL_SENT
+		email.SentAt = &now
+		email.LastError = ""
+	}
+
+	// Not saved, the email is claimed again once the lease is over
+	if err := u.outboxRepo.UpdateDelivery(email); err != nil {
+		u.logger.Errorf("Outbox: email %d: %v", email.ID, err)
+	}
+}
+
+func (u *outboxUseCase) GetDead() (*[]models.Email, error) {
+	return u.outboxRepo.GetByStatus(models.EMAIL_DEAD)
+}
+
+// The dead email is sent again by the next delivery run with a new set of attempts
+func (u *outboxUseCase) Retry(id uint) (*models.Email, error) {
+	email, err := u.outboxRepo.GetById(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if email.Status != models.EMAIL_DEAD {
+		return nil, errorHandler.HttpError{
+			HttpStatus: http.StatusBadRequest,
+			HttpError:  "Only dead emails can be retried",
+		}
+	}
+
+	email.Status = models.EMAIL_PENDING
+	email.Attempts = 0
+	email.NextAttemptAt = time.Now()
+
+	if err := u.outboxRepo.UpdateDelivery(email); err != nil {
+		return nil, err
+	}
+
+	return email, nil
+}
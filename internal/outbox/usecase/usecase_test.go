@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/outbox/mock"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// Sender failing with err, the emails are kept
+type fakeSender struct {
+	err  error
+	sent []*models.Email
+}
+
+func (s *fakeSender) Send(email *models.Email) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.sent = append(s.sent, email)
+
+	return nil
+}
+
+func newOutboxUseCase(ctrl *gomock.Controller, sender *fakeSender) (*mock.MockRepository, *outboxUseCase) {
+	mockOutboxRepo := mock.NewMockRepository(ctrl)
+	logger := logger.NewLogger()
+	logger.InitLogger()
+
+	useCase := NewOutboxUseCase(nil, mockOutboxRepo, sender, logger).(*outboxUseCase)
+
+	return mockOutboxRepo, useCase
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Minute, backoff(1))
+	assert.Equal(t, 2*time.Minute, backoff(2))
+	assert.Equal(t, 64*time.Minute, backoff(7))
+}
+
+func TestDeliver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sender := &fakeSender{}
+		mockOutboxRepo, useCase := newOutboxUseCase(ctrl, sender)
+
+		emails := &[]models.Email{{GormModel: models.GormModel{ID: 1}, Status: models.EMAIL_PENDING, Attempts: 1, LastError: "previous"}}
+
+		mockOutboxRepo.EXPECT().Claim(gomock.Any(), claimLease, batchSize).Return(emails, nil)
+		mockOutboxRepo.EXPECT().UpdateDelivery(&(*emails)[0]).Return(nil)
+
+		assert.NoError(t, useCase.Deliver())
+
+		email := (*emails)[0]
+		assert.Len(t, sender.sent, 1)
+		assert.Equal(t, models.EMAIL_SENT, email.Status)
+		assert.NotNil(t, email.SentAt)
+		assert.Empty(t, email.LastError)
+	})
+
+	t.Run("failure is retried later", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockOutboxRepo, useCase := newOutboxUseCase(ctrl, &fakeSender{err: errors.New("smtp down")})
+
+		emails := &[]models.Email{{GormModel: models.GormModel{ID: 1}, Status: models.EMAIL_PENDING, Attempts: 3}}
+
+		mockOutboxRepo.EXPECT().Claim(gomock.Any(), claimLease, batchSize).Return(emails, nil)
+		mockOutboxRepo.EXPECT().UpdateDelivery(gomock.Any()).Return(nil)
+
+		before := time.Now()
+		assert.NoError(t, useCase.Deliver())
+
+		email := (*emails)[0]
+		assert.Equal(t, models.EMAIL_PENDING, email.Status)
+		assert.Equal(t, "smtp down", email.LastError)
+		assert.Nil(t, email.SentAt)
+		assert.WithinRange(t, email.NextAttemptAt, before.Add(backoff(3)), time.Now().Add(backoff(3)))
+	})
+
+	t.Run("failure after the last attempt is dead", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockOutboxRepo, useCase := newOutboxUseCase(ctrl, &fakeSender{err: errors.New("mailbox unavailable")})
+
+		emails := &[]models.Email{{GormModel: models.GormModel{ID: 1}, Status: models.EMAIL_PENDING, Attempts: maxAttempts}}
+
+		mockOutboxRepo.EXPECT().Claim(gomock.Any(), claimLease, batchSize).Return(emails, nil)
+		mockOutboxRepo.EXPECT().UpdateDelivery(gomock.Any()).Return(nil)
+
+		assert.NoError(t, useCase.Deliver())
+
+		email := (*emails)[0]
+		assert.Equal(t, models.EMAIL_DEAD, email.Status)
+		assert.Equal(t, "mailbox unavailable", email.LastError)
+	})
+
+	t.Run("claims until a batch is not full", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sender := &fakeSender{}
+		mockOutboxRepo, useCase := newOutboxUseCase(ctrl, sender)
+
+		full := make([]models.Email, batchSize)
+		last := []models.Email{{}}
+
+		gomock.InOrder(
+			mockOutboxRepo.EXPECT().Claim(gomock.Any(), claimLease, batchSize).Return(&full, nil),
+			mockOutboxRepo.EXPECT().Claim(gomock.Any(), claimLease, batchSize).Return(&last, nil),
+		)
+		mockOutboxRepo.EXPECT().UpdateDelivery(gomock.Any()).Return(nil).Times(batchSize + 1)
+
+		assert.NoError(t, useCase.Deliver())
+		assert.Len(t, sender.sent, batchSize+1)
+	})
+
+	t.Run("save error is not fatal", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sender := &fakeSender{}
+		mockOutboxRepo, useCase := newOutboxUseCase(ctrl, sender)
+
+		emails := &[]models.Email{{GormModel: models.GormModel{ID: 1}}, {GormModel: models.GormModel{ID: 2}}}
+
+		mockOutboxRepo.EXPECT().Claim(gomock.Any(), claimLease, batchSize).Return(emails, nil)
+		mockOutboxRepo.EXPECT().UpdateDelivery(gomock.Any()).Return(errors.New("some error")).Times(2)
+
+		assert.NoError(t, useCase.Deliver())
+		assert.Len(t, sender.sent, 2)
+	})
+
+	t.Run("claim error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockOutboxRepo, useCase := newOutboxUseCase(ctrl, &fakeSender{})
+
+		mockOutboxRepo.EXPECT().Claim(gomock.Any(), claimLease, batchSize).Return(nil, errors.New("some error"))
+
+		assert.Error(t, useCase.Deliver())
+	})
+}
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockOutboxRepo, useCase := newOutboxUseCase(ctrl, &fakeSender{})
+
+		email := &models.Email{GormModel: models.GormModel{ID: 1}, Status: models.EMAIL_DEAD, Attempts: maxAttempts, LastError: "smtp down"}
+
+		mockOutboxRepo.EXPECT().GetById(uint(1)).Return(email, nil)
+		mockOutboxRepo.EXPECT().UpdateDelivery(email).Return(nil)
+
+		retried, err := useCase.Retry(1)
+		assert.NoError(t, err)
+		assert.Equal(t, models.EMAIL_PENDING, retried.Status)
+		assert.Zero(t, retried.Attempts)
+		assert.False(t, retried.NextAttemptAt.After(time.Now()))
+	})
+
+	t.Run("not dead", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockOutboxRepo, useCase := newOutboxUseCase(ctrl, &fakeSender{})
+
+		for _, status := range []models.EmailStatus{models.EMAIL_PENDING, models.EMAIL_SENT} {
+			mockOutboxRepo.EXPECT().GetById(uint(1)).Return(&models.Email{Status: status}, nil)
+
+			retried, err := useCase.Retry(1)
+			assert.Nil(t, retried)
+			assert.Equal(t, errorHandler.HttpError{
+				HttpStatus: http.StatusBadRequest,
+				HttpError:  "Only dead emails can be retried",
+			}, err)
+		}
+	})
+
+	t.Run("error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockOutboxRepo, useCase := newOutboxUseCase(ctrl, &fakeSender{})
+
+		mockOutboxRepo.EXPECT().GetById(uint(1)).Return(nil, errors.New("some error"))
+
+		retried, err := useCase.Retry(1)
+		assert.Error(t, err)
+		assert.Nil(t, retried)
+	})
+}
@@ -5,32 +5,45 @@ import (
 	"net/http"
 
 	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/chat"
 	"github.com/esgi-challenge/backend/internal/class"
 	"github.com/esgi-challenge/backend/internal/course"
 	"github.com/esgi-challenge/backend/internal/document"
 	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/notification"
 	"github.com/esgi-challenge/backend/internal/project"
 	"github.com/esgi-challenge/backend/pkg/errorHandler"
 	"github.com/esgi-challenge/backend/pkg/logger"
 )
 
 type projectUseCase struct {
-	projectRepo     project.Repository
-	courseUseCase   course.UseCase
-	classUseCase    class.UseCase
-	documentUseCase document.UseCase
-	cfg             *config.Config
-	logger          logger.Logger
+	projectRepo         project.Repository
+	courseUseCase       course.UseCase
+	classUseCase        class.UseCase
+	documentUseCase     document.UseCase
+	chatUseCase         chat.UseCase
+	notificationUseCase notification.UseCase
+	cfg                 *config.Config
+	logger              logger.Logger
 }
 
-func NewProjectUseCase(cfg *config.Config, projectRepo project.Repository, courseUseCase course.UseCase, classUseCase class.UseCase, documentUseCase document.UseCase, logger logger.Logger) project.UseCase {
+func NewProjectUseCase(cfg *config.Config, projectRepo project.Repository, courseUseCase course.UseCase, classUseCase class.UseCase, documentUseCase document.UseCase, chatUseCase chat.UseCase, notificationUseCase notification.UseCase, logger logger.Logger) project.UseCase {
 	return &projectUseCase{
-		cfg:             cfg,
-		projectRepo:     projectRepo,
-		courseUseCase:   courseUseCase,
-		classUseCase:    classUseCase,
-		documentUseCase: documentUseCase,
-		logger:          logger,
+		cfg:                 cfg,
+		projectRepo:         projectRepo,
+		courseUseCase:       courseUseCase,
+		classUseCase:        classUseCase,
+		documentUseCase:     documentUseCase,
+		chatUseCase:         chatUseCase,
+		notificationUseCase: notificationUseCase,
+		logger:              logger,
+	}
+}
+
+// A failed sync is fixed by the periodic one
+func (u *projectUseCase) syncGroupChannel(projectId uint, group uint) {
+	if err := u.chatUseCase.SyncProjectGroupChannel(projectId, group); err != nil {
+		u.logger.Errorf("Chat: project %d group %d channel: %v", projectId, group, err)
 	}
 }
 
@@ -58,6 +71,20 @@ func (u *projectUseCase) Create(user *models.User, project *models.Project) (*mo
 		return nil, err
 	}
 
+	// The project is created even if the class could not be notified
+	err = u.notificationUseCase.NotifyClass(project.ClassId, &models.Notification{
+		Type:       models.NOTIFICATION_PROJECT,
+		MessageKey: models.NOTIFICATION_MESSAGE_PROJECT_CREATED,
+		MessageParams: map[string]string{
+			"project": project.Title,
+			"endDate": project.EndDate.Format("02/01/2006"),
+		},
+		RefId: &project.ID,
+	})
+	if err != nil {
+		u.logger.Errorf("Notification: project %d: %v", project.ID, err)
+	}
+
 	//Retrieve preload
 	return u.projectRepo.GetPreloadById(project.ID)
 }
@@ -84,11 +111,19 @@ func (u *projectUseCase) JoinProject(user *models.User, join *models.ProjectStud
 
 	fmt.Println(*join.Group)
 
-	return u.projectRepo.JoinProject(&models.ProjectStudent{
+	joinedProject, err := u.projectRepo.JoinProject(&models.ProjectStudent{
 		Group:     *join.Group,
 		ProjectId: project.ID,
 		StudentId: user.ID,
 	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	u.syncGroupChannel(project.ID, joinedProject.Group)
+
+	return joinedProject, nil
 }
 
 func (u *projectUseCase) QuitProject(user *models.User, id uint) error {
@@ -98,9 +133,23 @@ func (u *projectUseCase) QuitProject(user *models.User, id uint) error {
 		return err
 	}
 
+	joined, err := u.projectRepo.GetJoined(project.ID, user.ID)
+
+	if err != nil {
+		return err
+	}
+
 	err = u.projectRepo.DeleteJoined(project.ID, user.ID)
 
-	return err
+	if err != nil {
+		return err
+	}
+
+	for _, projectStudent := range *joined {
+		u.syncGroupChannel(project.ID, projectStudent.Group)
+	}
+
+	return nil
 }
 
 func (u *projectUseCase) GetAll(user *models.User) (*[]models.Project, error) {
@@ -202,5 +251,13 @@ func (u *projectUseCase) Delete(user *models.User, id uint) error {
 		return err
 	}
 
-	return u.projectRepo.Delete(id)
+	if err := u.projectRepo.Delete(id); err != nil {
+		return err
+	}
+
+	if err := u.chatUseCase.DeleteChannels(models.CHANNEL_PROJECT_GROUP, id); err != nil {
+		u.logger.Errorf("Chat: project %d channels: %v", id, err)
+	}
+
+	return nil
 }
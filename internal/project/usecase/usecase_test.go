@@ -5,10 +5,12 @@ import (
 	"testing"
 	"time"
 
+	chatMock "github.com/esgi-challenge/backend/internal/chat/mock"
 	classMock "github.com/esgi-challenge/backend/internal/class/mock"
 	courseMock "github.com/esgi-challenge/backend/internal/course/mock"
 	documentMock "github.com/esgi-challenge/backend/internal/document/mock"
 	"github.com/esgi-challenge/backend/internal/models"
+	notificationMock "github.com/esgi-challenge/backend/internal/notification/mock"
 	"github.com/esgi-challenge/backend/internal/project/mock"
 	"github.com/esgi-challenge/backend/pkg/logger"
 	"github.com/stretchr/testify/assert"
@@ -25,9 +27,11 @@ func TestCreateProject(t *testing.T) {
 	mockCourseUsecase := courseMock.NewMockUseCase(ctrl)
 	mockClassUsecase := classMock.NewMockUseCase(ctrl)
 	mockDocumentUsecase := documentMock.NewMockUseCase(ctrl)
+	mockChatUsecase := chatMock.NewMockUseCase(ctrl)
+	mockNotificationUsecase := notificationMock.NewMockUseCase(ctrl)
 	logger := logger.NewLogger()
 
-	useCase := NewProjectUseCase(nil, mockProjectRepo, mockCourseUsecase, mockClassUsecase, mockDocumentUsecase, logger)
+	useCase := NewProjectUseCase(nil, mockProjectRepo, mockCourseUsecase, mockClassUsecase, mockDocumentUsecase, mockChatUsecase, mockNotificationUsecase, logger)
 
 	t.Run("success", func(t *testing.T) {
 		user := &models.User{GormModel: models.GormModel{ID: 1}}
@@ -37,6 +41,7 @@ func TestCreateProject(t *testing.T) {
 		mockDocumentUsecase.EXPECT().GetById(gomock.Any(), gomock.Any()).Return(nil, nil)
 		mockClassUsecase.EXPECT().GetById(gomock.Any()).Return(nil, nil)
 		mockProjectRepo.EXPECT().Create(project).Return(project, nil)
+		mockNotificationUsecase.EXPECT().NotifyClass(project.ClassId, gomock.Any()).Return(nil)
 		mockProjectRepo.EXPECT().GetPreloadById(project.ID).Return(project, nil)
 
 		createdProject, err := useCase.Create(user, project)
@@ -55,9 +60,11 @@ func TestGetAllProjects(t *testing.T) {
 	mockCourseUsecase := courseMock.NewMockUseCase(ctrl)
 	mockClassUsecase := classMock.NewMockUseCase(ctrl)
 	mockDocumentUsecase := documentMock.NewMockUseCase(ctrl)
+	mockChatUsecase := chatMock.NewMockUseCase(ctrl)
+	mockNotificationUsecase := notificationMock.NewMockUseCase(ctrl)
 	logger := logger.NewLogger()
 
-	useCase := NewProjectUseCase(nil, mockProjectRepo, mockCourseUsecase, mockClassUsecase, mockDocumentUsecase, logger)
+	useCase := NewProjectUseCase(nil, mockProjectRepo, mockCourseUsecase, mockClassUsecase, mockDocumentUsecase, mockChatUsecase, mockNotificationUsecase, logger)
 
 	user := &models.User{
 		UserKind: models.NewUserKind(models.TEACHER),
@@ -202,11 +202,15 @@ func (u *scheduleHandlers) CheckSign() gin.HandlerFunc {
 // Read
 //
 //	@Summary		Get all schedule
-//	@Description	Get all schedule
+//	@Description	Get all schedule. With events, a timetable of the schedules and of the events of the period targeting the user is returned instead, every event of the school for its administrator
 //	@Tags			Schedule
 //	@Produce		json
-//	@Success		200	{object}	[]models.Schedule
-//	@Failure		500	{object}	errorHandler.HttpErr
+//	@Param			events	query		bool	false	"Include the events"
+//	@Param			from	query		int		false	"Unix timestamp, events ending after it, now by default"
+//	@Param			to		query		int		false	"Unix timestamp, events starting before it"
+//	@Success		200		{object}	[]models.Schedule
+//	@Failure		400		{object}	errorHandler.HttpErr
+//	@Failure		500		{object}	errorHandler.HttpErr
 //	@Router			/schedules [get]
 func (u *scheduleHandlers) GetAll() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
@@ -217,6 +221,35 @@ func (u *scheduleHandlers) GetAll() gin.HandlerFunc {
 			return
 		}
 
+		withEvents, err := strconv.ParseBool(ctx.DefaultQuery("events", "false"))
+
+		if err != nil {
+			ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+			u.logger.Infof("Request: %v", err.Error())
+			return
+		}
+
+		if withEvents {
+			period, err := request.ValidateEventPeriod(ctx)
+
+			if err != nil {
+				ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+				u.logger.Infof("Request: %v", err.Error())
+				return
+			}
+
+			timetable, err := u.scheduleUseCase.GetTimetable(user, period)
+
+			if err != nil {
+				ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+				u.logger.Infof("Request: %v", err.Error())
+				return
+			}
+
+			ctx.JSON(http.StatusOK, timetable)
+			return
+		}
+
 		schedules, err := u.scheduleUseCase.GetAllByUser(user)
 
 		if err != nil {
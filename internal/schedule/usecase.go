@@ -9,6 +9,8 @@ type UseCase interface {
 	GetAll(user *models.User) (*[]models.ScheduleGet, error)
 	GetUnattended(user *models.User) (*[]models.ScheduleGet, error)
 	GetAllByUser(user *models.User) (*[]models.Schedule, error)
+	// Schedules of the user with the events of the period targeting them
+	GetTimetable(user *models.User, period *models.EventPeriod) (*models.Timetable, error)
 	GetById(user *models.User, id uint) (*models.ScheduleGet, error)
 	GetPreloadById(scheduleId uint) (*models.Schedule, error)
 	Sign(signature *models.ScheduleSignatureCreate, user *models.User, id uint) (*models.ScheduleSignature, error)
@@ -7,7 +7,9 @@ import (
 	"github.com/esgi-challenge/backend/config"
 	"github.com/esgi-challenge/backend/internal/campus"
 	"github.com/esgi-challenge/backend/internal/course"
+	"github.com/esgi-challenge/backend/internal/event"
 	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/internal/notification"
 	"github.com/esgi-challenge/backend/internal/path"
 	"github.com/esgi-challenge/backend/internal/schedule"
 	"github.com/esgi-challenge/backend/internal/school"
@@ -18,26 +20,58 @@ import (
 )
 
 type scheduleUseCase struct {
-	scheduleRepo schedule.Repository
-	courseRepo   course.Repository
-	schoolRepo   school.Repository
-	pathRepo     path.Repository
-	campusRepo   campus.Repository
-	userRepo     user.Repository
-	cfg          *config.Config
-	logger       logger.Logger
+	scheduleRepo        schedule.Repository
+	courseRepo          course.Repository
+	schoolRepo          school.Repository
+	pathRepo            path.Repository
+	campusRepo          campus.Repository
+	userRepo            user.Repository
+	eventUseCase        event.UseCase
+	notificationUseCase notification.UseCase
+	cfg                 *config.Config
+	logger              logger.Logger
 }
 
-func NewScheduleUseCase(cfg *config.Config, scheduleRepo schedule.Repository, courseRepo course.Repository, pathRepo path.Repository, schoolRepo school.Repository, campusRepo campus.Repository, userRepo user.Repository, logger logger.Logger) schedule.UseCase {
+func NewScheduleUseCase(cfg *config.Config, scheduleRepo schedule.Repository, courseRepo course.Repository, pathRepo path.Repository, schoolRepo school.Repository, campusRepo campus.Repository, userRepo user.Repository, eventUseCase event.UseCase, notificationUseCase notification.UseCase, logger logger.Logger) schedule.UseCase {
 	return &scheduleUseCase{
-		cfg:          cfg,
-		scheduleRepo: scheduleRepo,
-		courseRepo:   courseRepo,
-		schoolRepo:   schoolRepo,
-		campusRepo:   campusRepo,
-		userRepo:     userRepo,
-		pathRepo:     pathRepo,
-		logger:       logger,
+		cfg:                 cfg,
+		scheduleRepo:        scheduleRepo,
+		courseRepo:          courseRepo,
+		schoolRepo:          schoolRepo,
+		campusRepo:          campusRepo,
+		userRepo:            userRepo,
+		pathRepo:            pathRepo,
+		eventUseCase:        eventUseCase,
+		notificationUseCase: notificationUseCase,
+		logger:              logger,
+	}
+}
+
+// The schedule is saved even if the class could not be notified
+func (u *scheduleUseCase) notifyClass(schedule *models.Schedule, key models.NotificationMessageKey) {
+	location := models.TimeLocation(models.DefaultTimezone)
+
+	if campus, err := u.campusRepo.GetById(schedule.CampusId); err == nil {
+		location = campus.TimeLocation()
+	}
+
+	params := map[string]string{
+		"time": time.Unix(int64(schedule.Time), 0).In(location).Format(models.NotificationTimeLayout),
+	}
+
+	if course, err := u.courseRepo.GetById(schedule.CourseId); err == nil {
+		params["course"] = course.Name
+	}
+
+	err := u.notificationUseCase.NotifyClass(schedule.ClassId, &models.Notification{
+		Type:          models.NOTIFICATION_SCHEDULE,
+		MessageKey:    key,
+		MessageParams: params,
+		RefId:         &schedule.ID,
+	})
+
+	if err != nil {
+		u.logger.Errorf("Notification: schedule %d: %v", schedule.ID, err)
 	}
 }
 
@@ -81,7 +115,7 @@ func (u *scheduleUseCase) Create(user *models.User, schedule *models.ScheduleCre
 		}
 	}
 
-	return u.scheduleRepo.Create(&models.Schedule{
+	dbSchedule, err := u.scheduleRepo.Create(&models.Schedule{
 		Time:          *schedule.Time,
 		Duration:      *schedule.Duration,
 		SignatureCode: uuid.NewString(),
@@ -91,6 +125,13 @@ func (u *scheduleUseCase) Create(user *models.User, schedule *models.ScheduleCre
 		ClassId:       *schedule.ClassId,
 		SchoolId:      school.ID,
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.notifyClass(dbSchedule, models.NOTIFICATION_MESSAGE_SCHEDULE_CREATED)
+
+	return dbSchedule, nil
 }
 
 func (u *scheduleUseCase) Sign(signature *models.ScheduleSignatureCreate, user *models.User, scheduleId uint) (*models.ScheduleSignature, error) {
@@ -242,6 +283,23 @@ func (u *scheduleUseCase) GetAllByUser(user *models.User) (*[]models.Schedule, e
 	}
 }
 
+func (u *scheduleUseCase) GetTimetable(user *models.User, period *models.EventPeriod) (*models.Timetable, error) {
+	schedules, err := u.GetAllByUser(user)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := u.eventUseCase.GetAll(user, period)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Timetable{
+		Schedules: *schedules,
+		Events:    *events,
+	}, nil
+}
+
 func (u *scheduleUseCase) GetPreloadById(scheduleId uint) (*models.Schedule, error) {
 	return u.scheduleRepo.GetPreloadById(scheduleId)
 }
@@ -305,17 +363,34 @@ func (u *scheduleUseCase) Update(user *models.User, id uint, updatedSchedule *mo
 	}
 
 	updatedSchedule.ID = id
-	return u.scheduleRepo.Update(id, updatedSchedule)
+	schedule, err := u.scheduleRepo.Update(id, updatedSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	// Students of a class the course was moved away from must know too
+	if dbSchedule.Schedule.ClassId != schedule.ClassId {
+		u.notifyClass(&dbSchedule.Schedule, models.NOTIFICATION_MESSAGE_SCHEDULE_CANCELLED)
+	}
+	u.notifyClass(schedule, models.NOTIFICATION_MESSAGE_SCHEDULE_UPDATED)
+
+	return schedule, nil
 }
 
 func (u *scheduleUseCase) Delete(user *models.User, id uint) error {
 	// Check not needed but added to handle a not found error because gorm do not return
 	// error if delete on a row that does not exist
-	_, err := u.GetById(user, id)
+	dbSchedule, err := u.GetById(user, id)
 
 	if err != nil {
 		return err
 	}
 
-	return u.scheduleRepo.Delete(id)
+	if err := u.scheduleRepo.Delete(id); err != nil {
+		return err
+	}
+
+	u.notifyClass(&dbSchedule.Schedule, models.NOTIFICATION_MESSAGE_SCHEDULE_CANCELLED)
+
+	return nil
 }
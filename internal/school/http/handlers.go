@@ -8,7 +8,6 @@ import (
 	"github.com/esgi-challenge/backend/internal/models"
 	"github.com/esgi-challenge/backend/internal/school"
 	"github.com/esgi-challenge/backend/internal/user"
-	"github.com/esgi-challenge/backend/pkg/email"
 	"github.com/esgi-challenge/backend/pkg/errorHandler"
 	"github.com/esgi-challenge/backend/pkg/logger"
 	"github.com/esgi-challenge/backend/pkg/request"
@@ -264,6 +263,7 @@ func (u *schoolHandlers) Invite() gin.HandlerFunc {
 			Lastname:  schoolInvite.Lastname,
 			Email:     schoolInvite.Email,
 			Type:      schoolInvite.Type,
+			Locale:    schoolInvite.Locale,
 		}
 
 		invitedUser, err := u.schoolUseCase.Invite(user, school)
@@ -274,14 +274,6 @@ func (u *schoolHandlers) Invite() gin.HandlerFunc {
 			return
 		}
 
-		emailM := email.InitEmailManager(u.cfg.Smtp.Username, u.cfg.Smtp.Password, u.cfg.Smtp.Host)
-		err = emailM.SendInvitationEmail([]string{invitedUser.Email}, invitedUser.Firstname, invitedUser.Lastname, invitedUser.InvitationCode)
-		if err != nil {
-			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
-			u.logger.Infof("Request: %v", err.Error())
-			return
-		}
-
 		ctx.JSON(http.StatusCreated, invitedUser)
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/esgi-challenge/backend/internal/models"
 	"github.com/esgi-challenge/backend/internal/school"
 	"github.com/esgi-challenge/backend/internal/user"
+	"github.com/esgi-challenge/backend/pkg/email"
 	"github.com/esgi-challenge/backend/pkg/errorHandler"
 	"github.com/esgi-challenge/backend/pkg/logger"
 	"github.com/google/uuid"
@@ -93,14 +94,28 @@ func (u *schoolUseCase) Invite(user *models.User, schoolInvite *models.SchoolInv
 		userKind = models.TEACHER
 	}
 
-	return u.userRepo.Create(&models.User{
+	locale := schoolInvite.Locale
+	if locale == "" {
+		locale = models.DefaultLocale
+	}
+
+	invitedUser := &models.User{
 		Email:          schoolInvite.Email,
 		Lastname:       schoolInvite.Lastname,
 		Firstname:      schoolInvite.Firstname,
 		InvitationCode: uuid.NewString(),
 		SchoolId:       &school.ID,
 		UserKind:       &userKind,
-	})
+		Locale:         locale,
+	}
+
+	// The email is sent by the outbox worker
+	invitationEmail, err := email.InvitationEmail(u.cfg.EmailLinkBaseUrl, invitedUser.Email, invitedUser.Locale, invitedUser.Firstname, invitedUser.Lastname, invitedUser.InvitationCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.userRepo.CreateWithEmail(invitedUser, invitationEmail)
 }
 
 func (u *schoolUseCase) GetAll() (*[]models.School, error) {
@@ -1,8 +1,10 @@
 package server
 
 import (
+	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	_ "github.com/esgi-challenge/backend/docs"
 	"github.com/esgi-challenge/backend/internal/middleware"
@@ -45,6 +47,10 @@ import (
 	informationsRepo "github.com/esgi-challenge/backend/internal/informations/repository"
 	informationsUseCase "github.com/esgi-challenge/backend/internal/informations/usecase"
 
+	eventHttp "github.com/esgi-challenge/backend/internal/event/http"
+	eventRepo "github.com/esgi-challenge/backend/internal/event/repository"
+	eventUseCase "github.com/esgi-challenge/backend/internal/event/usecase"
+
 	projectHttp "github.com/esgi-challenge/backend/internal/project/http"
 	projectRepo "github.com/esgi-challenge/backend/internal/project/repository"
 	projectUseCase "github.com/esgi-challenge/backend/internal/project/usecase"
@@ -57,7 +63,22 @@ import (
 	noteRepo "github.com/esgi-challenge/backend/internal/note/repository"
 	noteUseCase "github.com/esgi-challenge/backend/internal/note/usecase"
 
+	notificationHttp "github.com/esgi-challenge/backend/internal/notification/http"
+	notificationRepo "github.com/esgi-challenge/backend/internal/notification/repository"
+	notificationUseCase "github.com/esgi-challenge/backend/internal/notification/usecase"
+
+	outboxHttp "github.com/esgi-challenge/backend/internal/outbox/http"
+	outboxRepo "github.com/esgi-challenge/backend/internal/outbox/repository"
+	outboxUseCase "github.com/esgi-challenge/backend/internal/outbox/usecase"
+
 	"github.com/esgi-challenge/backend/internal/websocket"
+	"github.com/esgi-challenge/backend/pkg/database"
+	"github.com/esgi-challenge/backend/pkg/email"
+	"github.com/esgi-challenge/backend/pkg/extract"
+	"github.com/esgi-challenge/backend/pkg/preview"
+	"github.com/esgi-challenge/backend/pkg/push"
+	"github.com/esgi-challenge/backend/pkg/realtime"
+	"github.com/esgi-challenge/backend/pkg/scanner"
 )
 
 func (s *Server) SetupHandlers() error {
@@ -70,10 +91,46 @@ func (s *Server) SetupHandlers() error {
 	courseRepo := courseRepo.NewCourseRepository(s.psqlDB)
 	scheduleRepo := scheduleRepo.NewScheduleRepository(s.psqlDB)
 	informationsRepo := informationsRepo.NewInformationsRepository(s.psqlDB)
+	eventRepo := eventRepo.NewEventRepository(s.psqlDB)
 	chatRepo := chatRepo.NewChatRepository(s.psqlDB)
 	projectRepo := projectRepo.NewProjectRepository(s.psqlDB)
 	documentRepo := documentRepo.NewDocumentRepository(s.psqlDB)
 	noteRepo := noteRepo.NewNoteRepository(s.psqlDB)
+	notificationRepo := notificationRepo.NewNotificationRepository(s.psqlDB)
+	outboxRepo := outboxRepo.NewOutboxRepository(s.psqlDB)
+
+	// Events sent to the websockets of every instance
+	realtimeHub, err := realtime.NewPostgresHub(s.psqlDB, database.PostgresDsn(s.cfg), s.logger)
+	if err != nil {
+		return err
+	}
+
+	// Sockets open on every instance, users without one get push notifications
+	presence, err := realtime.NewSharedPresence(s.psqlDB, s.logger)
+	if err != nil {
+		return err
+	}
+
+	pushProvider := push.NewNoopProvider()
+	if s.cfg.FcmProjectId != "" {
+		tokenSource, err := push.NewFcmTokenSource(context.Background(), s.cfg.FcmCredentialsFile)
+		if err != nil {
+			return err
+		}
+
+		pushProvider = push.NewFcmProvider(s.cfg.FcmEndpoint, s.cfg.FcmProjectId, tokenSource)
+	}
+
+	emailTransport, err := email.NewTransport(s.cfg)
+	if err != nil {
+		return err
+	}
+	emailSender := email.InitEmailManager(s.cfg.Smtp.Sender, emailTransport)
+
+	// Documents processing
+	clamdScanner := scanner.NewClamdScanner(s.cfg.ClamdAddress)
+	previewGenerator := preview.NewGenerator()
+	textExtractor := extract.NewExtractor()
 
 	// UseCase
 	userUseCase := userUseCase.NewUserUseCase(userRepo, s.cfg, s.logger)
@@ -81,14 +138,45 @@ func (s *Server) SetupHandlers() error {
 	authUseCase := authUseCase.NewAuthUseCase(s.cfg, userRepo, s.logger)
 	campusUseCase := campusUseCase.NewCampusUseCase(s.cfg, campusRepo, schoolRepo, s.logger)
 	pathUseCase := pathUseCase.NewPathUseCase(s.cfg, pathRepo, schoolRepo, s.logger)
-	classUseCase := classUseCase.NewClassUseCase(s.cfg, classRepo, pathRepo, schoolRepo, userRepo, s.logger)
-	courseUseCase := courseUseCase.NewCourseUseCase(s.cfg, courseRepo, pathRepo, schoolRepo, s.logger)
-	scheduleUseCase := scheduleUseCase.NewScheduleUseCase(s.cfg, scheduleRepo, courseRepo, pathRepo, schoolRepo, campusRepo, userRepo, s.logger)
-	informationsUseCase := informationsUseCase.NewInformationsUseCase(s.cfg, informationsRepo, schoolRepo, s.logger)
-	chatUseCase := chatUseCase.NewChatUseCase(s.cfg, chatRepo, schoolRepo, s.logger)
-	documentUseCase := documentUseCase.NewDocumentUseCase(s.cfg, documentRepo, courseRepo, schoolRepo, s.logger, *s.storage)
-	projectsUseCase := projectUseCase.NewProjectUseCase(s.cfg, projectRepo, courseUseCase, classUseCase, documentUseCase, s.logger)
-	noteUseCase := noteUseCase.NewNoteUseCase(s.cfg, noteRepo, s.logger)
+	notificationUseCase := notificationUseCase.NewNotificationUseCase(s.cfg, notificationRepo, realtimeHub, presence, pushProvider, s.logger)
+	chatUseCase := chatUseCase.NewChatUseCase(s.cfg, chatRepo, schoolRepo, classRepo, courseRepo, projectRepo, userRepo, notificationUseCase, realtimeHub, *s.storage, previewGenerator, s.logger)
+	classUseCase := classUseCase.NewClassUseCase(s.cfg, classRepo, pathRepo, schoolRepo, userRepo, chatUseCase, s.logger)
+	courseUseCase := courseUseCase.NewCourseUseCase(s.cfg, courseRepo, pathRepo, schoolRepo, chatUseCase, s.logger)
+	eventUseCase := eventUseCase.NewEventUseCase(s.cfg, eventRepo, schoolRepo, campusRepo, notificationUseCase, s.logger)
+	scheduleUseCase := scheduleUseCase.NewScheduleUseCase(s.cfg, scheduleRepo, courseRepo, pathRepo, schoolRepo, campusRepo, userRepo, eventUseCase, notificationUseCase, s.logger)
+	documentUseCase := documentUseCase.NewDocumentUseCase(s.cfg, documentRepo, courseRepo, schoolRepo, s.logger, *s.storage, clamdScanner, previewGenerator, textExtractor)
+	informationsUseCase := informationsUseCase.NewInformationsUseCase(s.cfg, informationsRepo, schoolRepo, documentUseCase, notificationUseCase, s.logger)
+	projectsUseCase := projectUseCase.NewProjectUseCase(s.cfg, projectRepo, courseUseCase, classUseCase, documentUseCase, chatUseCase, notificationUseCase, s.logger)
+	noteUseCase := noteUseCase.NewNoteUseCase(s.cfg, noteRepo, notificationUseCase, s.logger)
+	outboxUseCase := outboxUseCase.NewOutboxUseCase(s.cfg, outboxRepo, emailSender, s.logger)
+
+	// Emails written to the outbox with the change they are about
+	s.startJob("emails delivery", 15*time.Second, outboxUseCase.Deliver)
+	s.startJob("notification digests", time.Hour, notificationUseCase.SendDigests)
+	s.startJob("informations publication", time.Minute, informationsUseCase.NotifyPublished)
+
+	// Documents left pending by a restart, an unreachable scanner or a full scan queue
+	go func() {
+		if err := documentUseCase.ScanPending(); err != nil {
+			s.logger.Errorf("Scanner: %v", err)
+		}
+	}()
+
+	s.startJob("documents purge", time.Hour, documentUseCase.PurgeDeleted)
+	s.startJob("documents uploads purge", time.Hour, documentUseCase.PurgeExpiredUploads)
+
+	// Channels of the classes, courses and project groups created before group chats
+	go func() {
+		if err := chatUseCase.SyncGroupChannels(); err != nil {
+			s.logger.Errorf("Chat: %v", err)
+		}
+	}()
+	s.startJob("chat channels sync", 24*time.Hour, chatUseCase.SyncGroupChannels)
+	s.startJob("chat attachments purge", time.Hour, chatUseCase.PurgePendingAttachments)
+	s.startJob("documents reconcile", 24*time.Hour, func() error {
+		_, err := documentUseCase.Reconcile(false)
+		return err
+	})
 
 	// Handlers
 	userHandlers := userHttp.NewUserHandlers(userUseCase, s.cfg, s.logger)
@@ -100,10 +188,13 @@ func (s *Server) SetupHandlers() error {
 	courseHandlers := courseHttp.NewCourseHandlers(s.cfg, courseUseCase, schoolUseCase, s.logger)
 	scheduleHandlers := scheduleHttp.NewScheduleHandlers(s.cfg, scheduleUseCase, schoolUseCase, s.logger)
 	informationsHandlers := informationsHttp.NewInformationsHandlers(s.cfg, informationsUseCase, schoolUseCase, s.logger)
+	eventHandlers := eventHttp.NewEventHandlers(s.cfg, eventUseCase, schoolUseCase, s.logger)
 	chatHandlers := chatHttp.NewChatHandlers(s.cfg, chatUseCase, s.logger)
 	projectHandlers := projectHttp.NewProjectHandlers(s.cfg, projectsUseCase, s.logger)
 	documentHandlers := documentHttp.NewDocumentHandlers(s.cfg, documentUseCase, s.logger)
 	noteHandler := noteHttp.NewNoteHandlers(s.cfg, noteUseCase, s.logger)
+	notificationHandlers := notificationHttp.NewNotificationHandlers(s.cfg, notificationUseCase, s.logger)
+	outboxHandlers := outboxHttp.NewOutboxHandlers(s.cfg, outboxUseCase, s.logger)
 
 	// Middlewares
 	mw := middleware.InitMiddlewareManager(s.cfg, s.logger)
@@ -124,18 +215,18 @@ func (s *Server) SetupHandlers() error {
 	courseGroup := api.Group("/courses")
 	schedulesGroup := api.Group("/schedules")
 	informationsGroup := api.Group("/informations")
+	eventGroup := api.Group("/events")
 	chatGroup := api.Group("/chats")
 	projectGroup := api.Group("/projects")
 	documentGroup := api.Group("/documents")
 	noteGroup := api.Group("/notes")
+	notificationGroup := api.Group("/notifications")
+	outboxGroup := api.Group("/outbox")
 
-	websocketHandlers := &websocket.WebSocketHandler{
-		Cfg:         s.cfg,
-		ChatUseCase: chatUseCase,
-		Logger:      s.logger,
-	}
+	websocketHandlers := websocket.NewWebSocketHandler(s.cfg, chatUseCase, realtimeHub, presence, s.logger)
 	websocketGroup := api.Group("/ws/chat")
 	websocketGroup.GET("/:channelId", websocketHandlers.ChatHandler)
+	api.GET("/ws/notifications", websocketHandlers.NotificationHandler)
 
 	userHttp.SetupUserRoutes(userGroup, userHandlers)
 	schoolHttp.SetupSchoolRoutes(schoolGroup, schoolHandlers)
@@ -146,10 +237,13 @@ func (s *Server) SetupHandlers() error {
 	courseHttp.SetupCourseRoutes(courseGroup, courseHandlers)
 	scheduleHttp.SetupScheduleRoutes(schedulesGroup, scheduleHandlers)
 	informationsHttp.SetupInformationsRoutes(informationsGroup, informationsHandlers)
+	eventHttp.SetupEventRoutes(eventGroup, eventHandlers)
 	chatHttp.SetupChatRoutes(chatGroup, chatHandlers)
 	projectHttp.SetupProjectRoutes(projectGroup, projectHandlers)
 	documentHttp.SetupDocumentRoutes(documentGroup, documentHandlers)
 	noteHttp.SetupNoteRoutes(noteGroup, noteHandler)
+	notificationHttp.SetupNotificationRoutes(notificationGroup, notificationHandlers)
+	outboxHttp.SetupOutboxRoutes(outboxGroup, outboxHandlers)
 
 	wk.SetupPathRoutes(wellknown)
 
@@ -157,7 +251,7 @@ func (s *Server) SetupHandlers() error {
 	health.GET("", healthHandler())
 
 	s.logger.Info("Checking if admin existing...")
-	_, err := userRepo.GetByEmail(s.cfg.AdminEmail)
+	_, err = userRepo.GetByEmail(s.cfg.AdminEmail)
 
 	var userkind models.UserKind = models.SUPERADMIN
 
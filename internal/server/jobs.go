@@ -0,0 +1,17 @@
+package server
+
+import "time"
+
+// Run the job every interval until the process exits, failures are only logged
+func (s *Server) startJob(name string, interval time.Duration, job func() error) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if err := job(); err != nil {
+				s.logger.Errorf("Job %s: %v", name, err)
+			}
+		}
+	}()
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/esgi-challenge/backend/config"
 	"github.com/esgi-challenge/backend/internal/models"
 	"github.com/esgi-challenge/backend/internal/user"
-	"github.com/esgi-challenge/backend/pkg/email"
 	"github.com/esgi-challenge/backend/pkg/errorHandler"
 	"github.com/esgi-challenge/backend/pkg/logger"
 	"github.com/esgi-challenge/backend/pkg/request"
@@ -203,6 +202,7 @@ func (u *userHandlers) UpdateMe() gin.HandlerFunc {
 			UserKind:   userDb.UserKind,
 			SchoolId:   userDb.SchoolId,
 			ClassRefer: userDb.ClassRefer,
+			Locale:     meUpdate.Locale,
 		}
 		updatedMe, err := u.userUseCase.Update(userDb.ID, me)
 
@@ -286,7 +286,7 @@ func (u *userHandlers) SendResetMail() gin.HandlerFunc {
 			return
 		}
 
-		resetCode, err := u.userUseCase.SendResetMail(userReset.Email)
+		err = u.userUseCase.SendResetMail(userReset.Email)
 
 		if err != nil {
 			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
@@ -294,14 +294,6 @@ func (u *userHandlers) SendResetMail() gin.HandlerFunc {
 			return
 		}
 
-		emailM := email.InitEmailManager(u.cfg.Smtp.Username, u.cfg.Smtp.Password, u.cfg.Smtp.Host)
-		err = emailM.SendResetEmail([]string{userReset.Email}, resetCode)
-		if err != nil {
-			ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
-			u.logger.Infof("Request: %v", err.Error())
-			return
-		}
-
 		ctx.JSON(http.StatusNoContent, nil)
 	}
 }
@@ -12,5 +12,8 @@ type Repository interface {
 	GetByInvitationCode(invitationCode string) (*models.User, error)
 	GetByResetCode(resetCode string) (*models.User, error)
 	Update(id uint, user *models.User) (*models.User, error)
+	// Save the user and add the email to the outbox in the same transaction
+	CreateWithEmail(user *models.User, email *models.Email) (*models.User, error)
+	UpdateWithEmail(id uint, user *models.User, email *models.Email) (*models.User, error)
 	Delete(id uint) error
 }
@@ -86,6 +86,44 @@ func (r *userRepo) Update(id uint, user *models.User) (*models.User, error) {
 	return user, nil
 }
 
+func (r *userRepo) CreateWithEmail(user *models.User, email *models.Email) (*models.User, error) {
+	userDb, _ := r.GetByEmail(user.Email)
+
+	if userDb != nil {
+		return nil, gorm.ErrDuplicatedKey
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(user).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(email).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *userRepo) UpdateWithEmail(id uint, user *models.User, email *models.Email) (*models.User, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(user).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(email).Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
 func (r *userRepo) Delete(id uint) error {
 	if err := r.db.Debug().Delete(&models.User{}, id).Error; err != nil {
 		return err
@@ -31,23 +31,30 @@ func (u *userUseCase) GetById(id uint) (*models.User, error) {
 	return u.userRepo.GetById(id)
 }
 
-func (u *userUseCase) SendResetMail(email string) (string, error) {
-	user, err := u.userRepo.GetByEmail(email)
+// The email is sent by the outbox worker
+func (u *userUseCase) SendResetMail(to string) error {
+	user, err := u.userRepo.GetByEmail(to)
 
 	if err != nil {
-		return "", err
+		return err
 	}
 
 	resetCode := uuid.NewString()
 
 	user.PasswordResetCode = resetCode
 
-	_, err = u.userRepo.Update(
+	resetEmail, err := email.ResetEmail(u.cfg.EmailLinkBaseUrl, user.Email, user.Locale, resetCode)
+	if err != nil {
+		return err
+	}
+
+	_, err = u.userRepo.UpdateWithEmail(
 		user.ID,
 		user,
+		resetEmail,
 	)
 
-	return resetCode, err
+	return err
 }
 
 func (u *userUseCase) Update(id uint, updatedUser *models.User) (*models.User, error) {
@@ -61,14 +68,22 @@ func (u *userUseCase) Update(id uint, updatedUser *models.User) (*models.User, e
 	updatedUser.CreatedAt = dbUser.CreatedAt
 	///////////////////////////////////////
 
+	if updatedUser.Locale == "" {
+		updatedUser.Locale = dbUser.Locale
+	}
+
+	updatedUser.ID = id
+
+	// The previous address is told about the change, in the locale it was used with even when
+	// the locale changes too
 	if dbUser.Email != updatedUser.Email {
-		emailM := email.InitEmailManager(u.cfg.Smtp.Username, u.cfg.Smtp.Password, u.cfg.Smtp.Host)
-		err = emailM.SendUpdateEmail([]string{dbUser.Email}, updatedUser.Email)
+		updateEmail, err := email.UpdateEmail(dbUser.Email, dbUser.Locale, updatedUser.Email)
 		if err != nil {
 			return nil, err
 		}
+
+		return u.userRepo.UpdateWithEmail(id, updatedUser, updateEmail)
 	}
 
-	updatedUser.ID = id
 	return u.userRepo.Update(id, updatedUser)
 }
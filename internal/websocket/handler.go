@@ -2,167 +2,392 @@ package websocket
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/esgi-challenge/backend/config"
 	"github.com/esgi-challenge/backend/internal/chat"
 	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
 	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/esgi-challenge/backend/pkg/realtime"
 	"github.com/esgi-challenge/backend/pkg/request"
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
 )
 
-var upgrader = websocket.Upgrader{
-	CheckOrigin: func(r *http.Request) bool {
-		return true
-	},
-}
+const (
+	writeWait = 10 * time.Second
+	// A connection without pong for this long is considered dead, it is pinged a bit more often
+	pongWait = 60 * time.Second
+	// Largest frame read from the clients
+	maxFrameSize = 64 << 10
+	// Browsers can't set headers on websockets, they give the token as the protocol following this one
+	tokenSubprotocol = "bearer"
+)
 
 type WebSocketHandler struct {
 	Cfg         *config.Config
 	ChatUseCase chat.UseCase
+	Hub         realtime.Hub
+	Presence    *realtime.Presence
 	Logger      logger.Logger
-	clients     map[int]map[*websocket.Conn]bool
-	mu          sync.Mutex
+	upgrader    websocket.Upgrader
+	pongWait    time.Duration
 }
 
-func NewWebSocketHandler(cfg *config.Config, chatUseCase chat.UseCase, logger logger.Logger) *WebSocketHandler {
-	return &WebSocketHandler{
+func NewWebSocketHandler(cfg *config.Config, chatUseCase chat.UseCase, hub realtime.Hub, presence *realtime.Presence, logger logger.Logger) *WebSocketHandler {
+	h := &WebSocketHandler{
 		Cfg:         cfg,
 		ChatUseCase: chatUseCase,
+		Hub:         hub,
+		Presence:    presence,
 		Logger:      logger,
-		clients:     make(map[int]map[*websocket.Conn]bool),
+		pongWait:    pongWait,
 	}
+
+	h.upgrader = websocket.Upgrader{
+		CheckOrigin:  h.checkOrigin,
+		Subprotocols: []string{tokenSubprotocol},
+	}
+
+	return h
 }
 
-func (h *WebSocketHandler) ChatHandler(ctx *gin.Context) {
-	conn, err := upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+// Clients without Origin aren't browsers, the others must be allowed or from the API host when no origin is configured
+func (h *WebSocketHandler) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+
+	if origin == "" {
+		return true
+	}
+
+	if len(h.Cfg.WsAllowedOrigins) > 0 {
+		return slices.Contains(h.Cfg.WsAllowedOrigins, origin)
+	}
+
+	u, err := url.Parse(origin)
 	if err != nil {
-		h.Logger.Errorf("Failed to set websocket upgrade: %+v", err)
-		return
+		return false
 	}
-	defer conn.Close()
 
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+// Token given in the token query parameter or as the protocol following "bearer"
+func handshakeToken(r *http.Request) string {
+	if token := r.URL.Query().Get("token"); token != "" {
+		return token
+	}
+
+	protocols := websocket.Subprotocols(r)
+
+	for i, protocol := range protocols {
+		if protocol == tokenSubprotocol && i+1 < len(protocols) {
+			return protocols[i+1]
+		}
+	}
+
+	return ""
+}
+
+// Frame sent by the clients once connected, its type is one of:
+//   - "message" (or no type): send Content and the uploaded AttachmentIds to the channel
+//   - "edit": replace the content of the message MessageId by Content
+//   - "delete": replace the message MessageId by a tombstone
+//   - "read": mark the channel read up to MessageId, or up to its latest message
+//   - "typing": tell the channel the user is typing, or stopped when Typing is false
+//   - "presence": ignored, the user is announced when it connects
+//
+// The server sends ChatEvent frames of the same types, and an "error" frame with the Status and
+// Error of a frame that failed
+type clientFrame struct {
+	Type          models.ChatEventType `json:"type"`
+	Content       string               `json:"content"`
+	MessageId     *uint                `json:"messageId"`
+	Typing        *bool                `json:"typing"`
+	AttachmentIds []uint               `json:"attachmentIds"`
+}
+
+// Gorilla connections support a single concurrent writer
+type client struct {
+	conn *websocket.Conn
+	mu   sync.Mutex
+}
+
+func (c *client) write(messageType int, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+
+	return c.conn.WriteMessage(messageType, data)
+}
+
+// The user and its channel membership are checked before the upgrade, the frames aren't authenticated
+func (h *WebSocketHandler) ChatHandler(ctx *gin.Context) {
 	channelID, err := strconv.Atoi(ctx.Param("channelId"))
 	if err != nil {
-		h.Logger.Errorf("Invalid channel ID: %+v", err)
+		ctx.AbortWithStatusJSON(errorHandler.UrlParamsErrorResponse())
+		h.Logger.Infof("Request: %v", err.Error())
 		return
 	}
 
-	h.mu.Lock()
-	// Ensure the main clients map is initialized
-	if h.clients == nil {
-		h.clients = make(map[int]map[*websocket.Conn]bool)
-		h.Logger.Info("Initialized the clients map")
+	user, err := request.ValidateRoleWithoutHeader(h.Cfg.JwtSecret, handshakeToken(ctx.Request), models.STUDENT)
+	if user == nil || err != nil {
+		ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+		return
 	}
 
-	// Register the client
-	if _, ok := h.clients[channelID]; !ok {
-		h.clients[channelID] = make(map[*websocket.Conn]bool)
-		h.Logger.Infof("Initialized the clients map for channelID %d", channelID)
+	channel, err := h.ChatUseCase.GetById(user, uint(channelID))
+	if err != nil {
+		ctx.AbortWithStatusJSON(errorHandler.ErrorResponse(err))
+		h.Logger.Infof("Request: %v", err.Error())
+		return
 	}
-	h.clients[channelID][conn] = true
-	h.mu.Unlock()
 
-	h.Logger.Infof("Client connected to channelID %d", channelID)
+	// The upgrader answers by itself when it fails
+	conn, err := h.upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		h.Logger.Errorf("Failed to set websocket upgrade: %+v", err)
+		return
+	}
+	defer conn.Close()
+
+	conn.SetReadLimit(maxFrameSize)
+	conn.SetReadDeadline(time.Now().Add(h.pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(h.pongWait))
+	})
+
+	c := &client{conn: conn}
+
+	// Events of the channel are relayed until the client disconnects
+	sub := h.Hub.Subscribe(models.ChatChannelTopic(channel.ID))
+	defer h.Hub.Unsubscribe(sub)
+
+	go h.writePump(c, sub)
+
+	h.connect(c, user, channel)
+	defer h.disconnect(user)
 
-	// Handle incoming messages
+	h.Logger.Infof("Client %d connected to channelID %d", user.ID, channel.ID)
+
+	// Handle incoming frames, a failed one is answered with an error frame and the next ones are
+	// still handled unless the connection can't go on
 	for {
 		_, message, err := conn.ReadMessage()
 		if err != nil {
-			h.Logger.Errorf("Error reading message: %+v", err)
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				h.Logger.Errorf("Error reading message: %+v", err)
+			}
 			break
 		}
 
-		var msgData map[string]interface{}
-		if err := json.Unmarshal(message, &msgData); err != nil {
-			h.Logger.Errorf("Error unmarshalling message: %+v", err)
-			conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInvalidFramePayloadData, "Invalid message format"))
-			continue
-		}
+		if err := h.handleFrame(user, channel, message); err != nil {
+			if errors.Is(err, chat.ErrNotMember) {
+				c.write(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, err.Error()))
+				break
+			}
 
-		token, ok := msgData["jwt"].(string)
-		if !ok {
-			h.Logger.Errorf("JWT token not found in message")
-			conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "JWT token required"))
-			continue
+			if err := h.sendError(c, channel.ID, err); err != nil {
+				h.Logger.Errorf("Failed to send error: %+v", err)
+				break
+			}
 		}
+	}
 
-		user, err := request.ValidateRoleWithoutHeader(h.Cfg.JwtSecret, token, models.STUDENT)
-		if user == nil || err != nil {
-			h.Logger.Errorf("Unauthorized user: %+v", err)
-			conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "Unauthorized"))
-			continue
+	h.Logger.Infof("Client %d disconnected from channelID %d", user.ID, channel.ID)
+}
+
+func (h *WebSocketHandler) handleFrame(user *models.User, channel *models.Channel, message []byte) error {
+	var frame clientFrame
+	if err := json.Unmarshal(message, &frame); err != nil {
+		h.Logger.Infof("Error unmarshalling message: %+v", err)
+		return errorHandler.NewHttpError(http.StatusBadRequest, "Invalid message format")
+	}
+
+	var err error
+
+	switch frame.Type {
+	case models.CHAT_EVENT_MESSAGE, "":
+		if frame.Content == "" && len(frame.AttachmentIds) == 0 {
+			return errorHandler.NewHttpError(http.StatusBadRequest, "Message content required")
 		}
 
-		channel, err := h.ChatUseCase.GetById(uint(channelID))
-		if err != nil || (channel.FirstUserId != user.ID && channel.SecondUserId != user.ID) {
-			h.Logger.Errorf("User not allowed in this channel: %+v", err)
-			conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "Forbidden"))
-			continue
+		// Broadcast by the use case to every socket of the channel
+		_, err = h.ChatUseCase.SendMessage(user, channel.ID, frame.Content, frame.AttachmentIds)
+	case models.CHAT_EVENT_EDIT, models.CHAT_EVENT_DELETE:
+		if frame.MessageId == nil {
+			return errorHandler.NewHttpError(http.StatusBadRequest, "Message ID required")
 		}
 
-		content, ok := msgData["content"].(string)
-		if !ok {
-			h.Logger.Errorf("Message content not found in message")
-			conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInvalidFramePayloadData, "Message content required"))
-			continue
+		if frame.Type == models.CHAT_EVENT_EDIT {
+			_, err = h.ChatUseCase.EditMessage(user, *frame.MessageId, frame.Content)
+		} else {
+			_, err = h.ChatUseCase.DeleteMessage(user, *frame.MessageId)
 		}
+	case models.CHAT_EVENT_READ:
+		err = h.ChatUseCase.MarkRead(user, channel.ID, &models.ChannelRead{MessageId: frame.MessageId})
+	case models.CHAT_EVENT_TYPING:
+		err = h.ChatUseCase.SendTyping(user, channel.ID, frame.Typing == nil || *frame.Typing)
+	case models.CHAT_EVENT_PRESENCE:
+		// Announced on connection
+	default:
+		return errorHandler.NewHttpError(http.StatusBadRequest, "Unknown frame type")
+	}
+
+	if err != nil {
+		h.Logger.Infof("Failed to handle %s frame: %+v", frame.Type, err)
+	}
+
+	return err
+}
+
+// The error of a frame is sent to its client only
+func (h *WebSocketHandler) sendError(c *client, channelId uint, err error) error {
+	httpErr := errorHandler.ParseError(err)
+
+	payload, err := json.Marshal(&models.ChatEvent{
+		Type:      models.CHAT_EVENT_ERROR,
+		ChannelId: channelId,
+		Status:    httpErr.Status(),
+		Error:     httpErr.Error(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.write(websocket.TextMessage, payload)
+}
+
+// Notifications of the user are pushed to the client, its frames are ignored
+func (h *WebSocketHandler) NotificationHandler(ctx *gin.Context) {
+	user, err := request.ValidateRoleWithoutHeader(h.Cfg.JwtSecret, handshakeToken(ctx.Request), models.STUDENT)
+	if user == nil || err != nil {
+		ctx.AbortWithStatusJSON(errorHandler.UnauthorizedErrorResponse())
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		h.Logger.Errorf("Failed to set websocket upgrade: %+v", err)
+		return
+	}
+	defer conn.Close()
+
+	conn.SetReadLimit(maxFrameSize)
+	conn.SetReadDeadline(time.Now().Add(h.pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(h.pongWait))
+	})
+
+	sub := h.Hub.Subscribe(models.NotificationUserTopic(user.ID))
+	defer h.Hub.Unsubscribe(sub)
+
+	go h.writePump(&client{conn: conn}, sub)
 
-		msg := &models.Message{
-			Content:   content,
-			ChannelId: uint(channelID),
-			SenderId:  user.ID,
+	// Users with the app open are online for the chat and don't get push notifications
+	h.online(user)
+	defer h.disconnect(user)
+
+	h.Logger.Infof("Client %d connected to notifications", user.ID)
+
+	// Reading is still needed to handle the pongs and the close frame
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				h.Logger.Errorf("Error reading message: %+v", err)
+			}
+			break
 		}
+	}
 
-		msg, err = h.ChatUseCase.SaveMessage(msg)
-		if err != nil {
-			h.Logger.Errorf("Failed to save message: %+v", err)
-			conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInternalServerErr, "Internal server error"))
-			continue
+	h.Logger.Infof("Client %d disconnected from notifications", user.ID)
+}
+
+// Relay the subscribed events and ping the client, the connection is closed on the first failure
+// which ends the read loop of the handler
+func (h *WebSocketHandler) writePump(c *client, sub *realtime.Subscription) {
+	ticker := time.NewTicker(h.pongWait * 9 / 10)
+	defer ticker.Stop()
+	defer c.conn.Close()
+
+	for {
+		select {
+		case payload, ok := <-sub.C:
+			// Unsubscribed or dropped by the hub for being too slow
+			if !ok {
+				return
+			}
+
+			if err := c.write(websocket.TextMessage, payload); err != nil {
+				h.Logger.Errorf("Error writing message: %+v", err)
+				return
+			}
+		case <-ticker.C:
+			if err := c.write(websocket.PingMessage, nil); err != nil {
+				h.Logger.Infof("Evicting dead connection: %+v", err)
+				return
+			}
 		}
+	}
+}
+
+// Send the client the members already online and tell the user channels it is online if
+// this is its first connection
+func (h *WebSocketHandler) connect(c *client, user *models.User, channel *models.Channel) {
+	h.online(user)
+
+	memberIds := []uint{}
+	for _, member := range channel.Members {
+		memberIds = append(memberIds, member.UserId)
+	}
 
-		h.Logger.Infof("Message sent: %+v", msg)
+	onlineMembers := h.Presence.OnlineUsers(memberIds)
 
-		response := map[string]interface{}{
-			"content":   msg.Content,
-			"channelId": msg.ChannelId,
-			"senderId":  msg.SenderId,
-			"createdAt": msg.CreatedAt,
+	for _, member := range channel.Members {
+		if member.UserId == user.ID || !onlineMembers[member.UserId] {
+			continue
 		}
-		responseMsg, err := json.Marshal(response)
+
+		online := true
+		payload, err := json.Marshal(&models.ChatEvent{
+			Type:      models.CHAT_EVENT_PRESENCE,
+			ChannelId: channel.ID,
+			UserId:    member.UserId,
+			Online:    &online,
+		})
 		if err != nil {
-			h.Logger.Errorf("Failed to marshal response message: %+v", err)
-			conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseInternalServerErr, "Internal server error"))
-			continue
+			h.Logger.Errorf("Failed to send presence: %+v", err)
+			return
 		}
 
-		h.broadcastMessage(channelID, responseMsg)
+		if err := c.write(websocket.TextMessage, payload); err != nil {
+			h.Logger.Errorf("Failed to send presence: %+v", err)
+			return
+		}
 	}
+}
 
-	// Unregister the client
-	h.mu.Lock()
-	delete(h.clients[channelID], conn)
-	// Remove the channel if no clients are left
-	if len(h.clients[channelID]) == 0 {
-		delete(h.clients, channelID)
-		h.Logger.Infof("Removed the clients map for channelID %d", channelID)
+func (h *WebSocketHandler) online(user *models.User) {
+	if h.Presence.Connect(user.ID) {
+		if err := h.ChatUseCase.PublishPresence(user.ID, true); err != nil {
+			h.Logger.Errorf("Failed to publish presence: %+v", err)
+		}
 	}
-	h.mu.Unlock()
-	h.Logger.Infof("Client disconnected from channelID %d", channelID)
 }
 
-func (h *WebSocketHandler) broadcastMessage(channelID int, message []byte) {
-	h.mu.Lock()
-	defer h.mu.Unlock()
-	for client := range h.clients[channelID] {
-		if err := client.WriteMessage(websocket.TextMessage, message); err != nil {
-			h.Logger.Errorf("Error writing message: %+v", err)
-			client.Close()
-			delete(h.clients[channelID], client)
+func (h *WebSocketHandler) disconnect(user *models.User) {
+	if h.Presence.Disconnect(user.ID) {
+		if err := h.ChatUseCase.PublishPresence(user.ID, false); err != nil {
+			h.Logger.Errorf("Failed to publish presence: %+v", err)
 		}
 	}
 }
@@ -0,0 +1,173 @@
+package websocket
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/chat"
+	"github.com/esgi-challenge/backend/internal/chat/mock"
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/esgi-challenge/backend/pkg/errorHandler"
+	"github.com/esgi-challenge/backend/pkg/jwt"
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/esgi-challenge/backend/pkg/realtime"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func setupServer(t *testing.T, h *WebSocketHandler) string {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.GET("/ws/chat/:channelId", h.ChatHandler)
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	return "ws" + strings.TrimPrefix(server.URL, "http") + "/ws/chat/5"
+}
+
+func TestChatHandler(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewLogger()
+	logger.InitLogger()
+	mockChatUseCase := mock.NewMockUseCase(ctrl)
+	cfg := &config.Config{JwtSecret: "secret", WsAllowedOrigins: []string{"https://app.example.com"}}
+	handler := NewWebSocketHandler(cfg, mockChatUseCase, realtime.NewLocalHub(), realtime.NewPresence(), logger)
+	url := setupServer(t, handler)
+
+	user := &models.User{GormModel: models.GormModel{ID: 7}, UserKind: models.NewUserKind(models.STUDENT)}
+	token, _ := jwt.Generate(cfg.JwtSecret, user)
+	channel := &models.Channel{GormModel: models.GormModel{ID: 5}}
+
+	mockChatUseCase.EXPECT().PublishPresence(uint(7), gomock.Any()).Return(nil).AnyTimes()
+
+	t.Run("missing token", func(t *testing.T) {
+		_, res, err := websocket.DefaultDialer.Dial(url, nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, res, err := websocket.DefaultDialer.Dial(url+"?token=invalid", nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+	})
+
+	t.Run("disallowed origin", func(t *testing.T) {
+		mockChatUseCase.EXPECT().GetById(gomock.Any(), uint(5)).Return(channel, nil)
+
+		_, res, err := websocket.DefaultDialer.Dial(url+"?token="+token, http.Header{"Origin": {"https://evil.example.com"}})
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	})
+
+	t.Run("not a member of the channel", func(t *testing.T) {
+		mockChatUseCase.EXPECT().GetById(gomock.Any(), uint(5)).Return(nil, chat.ErrNotMember)
+
+		_, res, err := websocket.DefaultDialer.Dial(url+"?token="+token, nil)
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	})
+
+	t.Run("token subprotocol and error frames", func(t *testing.T) {
+		mockChatUseCase.EXPECT().GetById(gomock.Any(), uint(5)).Return(channel, nil)
+		mockChatUseCase.EXPECT().SendMessage(gomock.Any(), uint(5), "hello", nil).Return(nil, errorHandler.HttpError{
+			HttpStatus: http.StatusForbidden,
+			HttpError:  "You can't message this user",
+		})
+		mockChatUseCase.EXPECT().SendTyping(gomock.Any(), uint(5), true).Return(nil)
+
+		dialer := websocket.Dialer{Subprotocols: []string{tokenSubprotocol, token}}
+		conn, _, err := dialer.Dial(url, http.Header{"Origin": {"https://app.example.com"}})
+		assert.NoError(t, err)
+		defer conn.Close()
+
+		assert.Equal(t, tokenSubprotocol, conn.Subprotocol())
+
+		var event models.ChatEvent
+
+		assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("not json")))
+		assert.NoError(t, conn.ReadJSON(&event))
+		assert.Equal(t, models.ChatEvent{Type: models.CHAT_EVENT_ERROR, ChannelId: 5, Status: http.StatusBadRequest, Error: "Invalid message format"}, event)
+
+		// The connection is still open after a failed frame
+		assert.NoError(t, conn.WriteJSON(clientFrame{Content: "hello"}))
+		assert.NoError(t, conn.ReadJSON(&event))
+		assert.Equal(t, models.ChatEvent{Type: models.CHAT_EVENT_ERROR, ChannelId: 5, Status: http.StatusForbidden, Error: "You can't message this user"}, event)
+
+		typing := true
+		assert.NoError(t, conn.WriteJSON(clientFrame{Type: models.CHAT_EVENT_TYPING, Typing: &typing}))
+
+		assert.NoError(t, conn.WriteJSON(clientFrame{Type: "unknown"}))
+		assert.NoError(t, conn.ReadJSON(&event))
+		assert.Equal(t, "Unknown frame type", event.Error)
+	})
+
+	t.Run("removed from the channel", func(t *testing.T) {
+		mockChatUseCase.EXPECT().GetById(gomock.Any(), uint(5)).Return(channel, nil)
+		mockChatUseCase.EXPECT().SendMessage(gomock.Any(), uint(5), "hello", nil).Return(nil, chat.ErrNotMember)
+
+		conn, _, err := websocket.DefaultDialer.Dial(url+"?token="+token, nil)
+		assert.NoError(t, err)
+		defer conn.Close()
+
+		assert.NoError(t, conn.WriteJSON(clientFrame{Content: "hello"}))
+
+		_, _, err = conn.ReadMessage()
+		assert.True(t, websocket.IsCloseError(err, websocket.ClosePolicyViolation))
+	})
+}
+
+func TestChatHandlerDeadConnection(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := logger.NewLogger()
+	logger.InitLogger()
+	mockChatUseCase := mock.NewMockUseCase(ctrl)
+	cfg := &config.Config{JwtSecret: "secret"}
+	handler := NewWebSocketHandler(cfg, mockChatUseCase, realtime.NewLocalHub(), realtime.NewPresence(), logger)
+	handler.pongWait = 200 * time.Millisecond
+	url := setupServer(t, handler)
+
+	user := &models.User{GormModel: models.GormModel{ID: 7}, UserKind: models.NewUserKind(models.STUDENT)}
+	token, _ := jwt.Generate(cfg.JwtSecret, user)
+
+	disconnected := make(chan bool)
+
+	mockChatUseCase.EXPECT().GetById(gomock.Any(), uint(5)).Return(&models.Channel{GormModel: models.GormModel{ID: 5}}, nil)
+	mockChatUseCase.EXPECT().PublishPresence(uint(7), true).Return(nil)
+	mockChatUseCase.EXPECT().PublishPresence(uint(7), false).DoAndReturn(func(userId uint, online bool) error {
+		close(disconnected)
+		return nil
+	})
+
+	// The client never reads, so it never answers the pings
+	conn, _, err := websocket.DefaultDialer.Dial(url+"?token="+token, nil)
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	select {
+	case <-disconnected:
+		assert.False(t, handler.Presence.IsOnline(7))
+	case <-time.After(5 * time.Second):
+		t.Fatal("the dead connection was not evicted")
+	}
+}
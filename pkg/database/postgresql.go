@@ -9,16 +9,19 @@ import (
 	"gorm.io/gorm"
 )
 
-func NewPostgresClient(c *config.Config) (*gorm.DB, error) {
-	dbUrl := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s",
+// Also used by the connections opened outside of gorm
+func PostgresDsn(c *config.Config) string {
+	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s",
 		c.Postgres.Host,
 		c.Postgres.User,
 		c.Postgres.Password,
 		c.Postgres.Dbname,
 		c.Postgres.Port,
 	)
+}
 
-	db, err := gorm.Open(postgres.Open(dbUrl), &gorm.Config{TranslateError: true})
+func NewPostgresClient(c *config.Config) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(PostgresDsn(c)), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, err
 	}
@@ -41,17 +44,69 @@ func migrateDatabase(db *gorm.DB) error {
 		&models.Schedule{},
 		&models.ScheduleSignature{},
 		&models.Informations{},
+		&models.InformationsTarget{},
+		&models.InformationsReceipt{},
+		&models.InformationsRevision{},
+		&models.Event{},
+		&models.EventTarget{},
+		&models.EventRsvp{},
 		&models.Channel{},
+		&models.ChannelMember{},
 		&models.Message{},
+		&models.MessageRevision{},
+		&models.MessageAttachment{},
+		&models.MessageReport{},
+		&models.UserBlock{},
+		&models.ChatMute{},
 		&models.Project{},
 		&models.ProjectStudent{},
 		&models.Document{},
+		&models.DocumentUpload{},
 		&models.Note{},
+		&models.Notification{},
+		&models.PushDevice{},
+		&models.NotificationPreference{},
+		&models.NotificationDigestItem{},
+		&models.Email{},
 	)
 
 	if err != nil {
 		return err
 	}
 
+	if err := createIndexes(db); err != nil {
+		return err
+	}
+
+	return backfillChannelMembers(db)
+}
+
+// Indexes on expressions or on embedded fields that can't be declared with gorm tags
+func createIndexes(db *gorm.DB) error {
+	indexes := []string{
+		fmt.Sprintf("CREATE INDEX IF NOT EXISTS idx_documents_search ON documents USING GIN ((%s))", models.DocumentSearchVector),
+		// Messages history pages and last message of the channels
+		"CREATE INDEX IF NOT EXISTS idx_messages_channel_history ON messages (channel_id, id DESC)",
+		fmt.Sprintf("CREATE INDEX IF NOT EXISTS idx_messages_search ON messages USING GIN ((%s))", models.MessageSearchVector),
+	}
+
+	for _, index := range indexes {
+		if err := db.Exec(index).Error; err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// Direct channels created before the members table, running it again inserts nothing
+func backfillChannelMembers(db *gorm.DB) error {
+	return db.Exec(`
+		INSERT INTO channel_members (channel_id, user_id, created_at)
+		SELECT id, first_user_id, created_at FROM channels WHERE kind = ? AND first_user_id IS NOT NULL
+		UNION
+		SELECT id, second_user_id, created_at FROM channels WHERE kind = ? AND second_user_id IS NOT NULL
+		ON CONFLICT DO NOTHING`,
+		models.CHANNEL_DIRECT, models.CHANNEL_DIRECT,
+	).Error
+}
@@ -0,0 +1,60 @@
+package email
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Transport keeping the emails instead of sending them, for local development and tests.
+// When dir is set each email is also written there as an .eml file
+type CaptureTransport struct {
+	mu       sync.Mutex
+	dir      string
+	messages []Message
+}
+
+func NewCaptureTransport(dir string) (*CaptureTransport, error) {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+
+	return &CaptureTransport{dir: dir}, nil
+}
+
+func (t *CaptureTransport) Deliver(message *Message) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.messages = append(t.messages, *message)
+
+	if t.dir == "" {
+		return nil
+	}
+
+	name := fmt.Sprintf("%s-%d.eml", time.Now().Format("20060102-150405"), len(t.messages))
+
+	return os.WriteFile(filepath.Join(t.dir, name), message.Raw, 0o644)
+}
+
+// Emails delivered so far, oldest first
+func (t *CaptureTransport) Messages() []Message {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	messages := make([]Message, len(t.messages))
+	copy(messages, t.messages)
+
+	return messages
+}
+
+func (t *CaptureTransport) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.messages = nil
+}
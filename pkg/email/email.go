@@ -2,44 +2,175 @@ package email
 
 import (
 	"bytes"
+	"embed"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"html/template"
-	"net/smtp"
+	"mime"
+	"mime/quotedprintable"
+	"strings"
+	"time"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/models"
 )
 
-const port = "587"
-const baseUrl = "https://challenge-esgi-preprod-backend-23gkj46hpq-ew.a.run.app"
+const (
+	TemplateInvitation    = "school-invitation"
+	TemplateResetPassword = "reset-password"
+	TemplateUpdateProfile = "update-profile"
+	// The subject of a notification email is its title
+	TemplateNotification = "notification"
+	TemplateDigest       = "digest"
+)
 
-type emailManager struct {
-	username string
-	password string
-	host     string
+//go:embed templates
+var templatesFS embed.FS
 
-	smtpAuth smtp.Auth
+// Template sets by locale, every locale has the same templates
+var templates = map[models.Locale]*template.Template{
+	models.LOCALE_FR: template.Must(template.ParseFS(templatesFS, "templates/fr/*.html")),
+	models.LOCALE_EN: template.Must(template.ParseFS(templatesFS, "templates/en/*.html")),
 }
 
-func InitEmailManager(username string, password string, host string) *emailManager {
-	return &emailManager{username, password, host, smtp.PlainAuth("", username, password, host)}
+var subjects = map[models.Locale]map[string]string{
+	models.LOCALE_FR: {
+		TemplateInvitation:    "Invitation sur Studies",
+		TemplateResetPassword: "Réinitialiser votre mot de passe",
+		TemplateUpdateProfile: "Votre nouvel email !",
+		TemplateDigest:        "Votre résumé Studies",
+	},
+	models.LOCALE_EN: {
+		TemplateInvitation:    "Studies Invitation",
+		TemplateResetPassword: "Reset your password",
+		TemplateUpdateProfile: "Your new email!",
+		TemplateDigest:        "Your Studies digest",
+	},
 }
 
-func (e *emailManager) sendEmail(to []string, subject string, template *template.Template, templateData any) error {
-	var body bytes.Buffer
+// Unknown locales, like the ones of emails queued before the users had one, use the default
+func localeOrDefault(locale models.Locale) models.Locale {
+	if _, ok := templates[locale]; ok {
+		return locale
+	}
+
+	return models.DefaultLocale
+}
 
-	mimeHeaders := "MIME-version: 1.0;\nContent-Type: text/html; charset=\"UTF-8\";\n\n"
-	body.Write([]byte(fmt.Sprintf("From: %s\nSubject: %s \n%s\n\n", e.username, subject, mimeHeaders)))
+type Sender interface {
+	Send(email *models.Email) error
+}
 
-	template.Execute(&body, templateData)
+type emailManager struct {
+	sender    string
+	transport Transport
+}
 
-	return smtp.SendMail(fmt.Sprintf("%s:%s", e.host, port), e.smtpAuth, e.username, to, body.Bytes())
+func InitEmailManager(sender string, transport Transport) *emailManager {
+	return &emailManager{sender: sender, transport: transport}
 }
 
-func (e *emailManager) SendInvitationEmail(to []string, name string, lastname string, invitationCode string) error {
-	t, err := template.ParseFiles("templates/emails/school-invitation.html")
+// Transport chosen by the config, SMTP unless the emails are captured
+func NewTransport(cfg *config.Config) (Transport, error) {
+	switch cfg.EmailTransport {
+	case "smtp":
+		if cfg.Smtp.Host == "" || cfg.Smtp.Username == "" || cfg.Smtp.Password == "" {
+			return nil, errors.New("SMTP_HOST, SMTP_USERNAME and SMTP_PASSWORD are required by the smtp email transport")
+		}
+
+		return NewSmtpTransport(cfg.Smtp.Host, cfg.Smtp.Port, cfg.Smtp.Username, cfg.Smtp.Password, TlsMode(cfg.Smtp.TlsMode))
+	case "capture":
+		return NewCaptureTransport(cfg.EmailCaptureDir)
+	default:
+		return nil, fmt.Errorf("unknown email transport %q", cfg.EmailTransport)
+	}
+}
 
+// Render the template of the email with its data and send it
+func (e *emailManager) Send(email *models.Email) error {
+	message, err := e.render(email)
 	if err != nil {
 		return err
 	}
 
+	return e.transport.Deliver(message)
+}
+
+func (e *emailManager) render(email *models.Email) (*Message, error) {
+	var templateData map[string]any
+	if err := json.Unmarshal(email.Data, &templateData); err != nil {
+		return nil, err
+	}
+
+	locale := localeOrDefault(email.Locale)
+	subject, ok := subjects[locale][email.Template]
+	if !ok {
+		subject = email.Subject
+	}
+
+	var html bytes.Buffer
+	if err := templates[locale].ExecuteTemplate(&html, email.Template+".html", templateData); err != nil {
+		return nil, err
+	}
+
+	var raw bytes.Buffer
+
+	headers := [][2]string{
+		{"From", e.sender},
+		{"To", email.To},
+		{"Subject", mime.QEncoding.Encode("UTF-8", subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", `text/html; charset="UTF-8"`},
+		{"Content-Transfer-Encoding", "quoted-printable"},
+	}
+
+	for _, header := range headers {
+		// Header values can't hold line breaks
+		value := strings.NewReplacer("\r", "", "\n", "").Replace(header[1])
+		fmt.Fprintf(&raw, "%s: %s\r\n", header[0], value)
+	}
+	raw.WriteString("\r\n")
+
+	body := quotedprintable.NewWriter(&raw)
+	if _, err := body.Write(html.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := body.Close(); err != nil {
+		return nil, err
+	}
+
+	return &Message{
+		From:    e.sender,
+		To:      []string{email.To},
+		Subject: subject,
+		Raw:     raw.Bytes(),
+	}, nil
+}
+
+// Email of the outbox, due now. The subject is kept for the admins, the email manager
+// uses the one of the locale when sending
+func newEmail(to string, locale models.Locale, template string, templateData any) (*models.Email, error) {
+	data, err := json.Marshal(templateData)
+	if err != nil {
+		return nil, err
+	}
+
+	locale = localeOrDefault(locale)
+
+	return &models.Email{
+		To:            to,
+		Subject:       subjects[locale][template],
+		Template:      template,
+		Locale:        locale,
+		Data:          data,
+		Status:        models.EMAIL_PENDING,
+		NextAttemptAt: time.Now(),
+	}, nil
+}
+
+func InvitationEmail(linkBaseUrl string, to string, locale models.Locale, name string, lastname string, invitationCode string) (*models.Email, error) {
 	templateData := struct {
 		Name           string
 		Lastname       string
@@ -47,55 +178,64 @@ func (e *emailManager) SendInvitationEmail(to []string, name string, lastname st
 	}{
 		Name:           name,
 		Lastname:       lastname,
-		InvitationLink: fmt.Sprintf("%s/invite/%s", baseUrl, invitationCode),
+		InvitationLink: fmt.Sprintf("%s/invite/%s", strings.TrimSuffix(linkBaseUrl, "/"), invitationCode),
 	}
 
-	err = e.sendEmail(to, "Studies Invitation", t, templateData)
-	if err != nil {
-		return err
+	return newEmail(to, locale, TemplateInvitation, templateData)
+}
+
+func ResetEmail(linkBaseUrl string, to string, locale models.Locale, resetCode string) (*models.Email, error) {
+	templateData := struct {
+		ResetLink string
+	}{
+		ResetLink: fmt.Sprintf("%s/reset-password/%s", strings.TrimSuffix(linkBaseUrl, "/"), resetCode),
 	}
 
-	return nil
+	return newEmail(to, locale, TemplateResetPassword, templateData)
 }
 
-func (e *emailManager) SendResetEmail(to []string, resetCode string) error {
-	t, err := template.ParseFiles("templates/emails/reset-password.html")
-
-	if err != nil {
-		return err
+func UpdateEmail(to string, locale models.Locale, email string) (*models.Email, error) {
+	templateData := struct {
+		NewEmail string
+	}{
+		NewEmail: email,
 	}
 
+	return newEmail(to, locale, TemplateUpdateProfile, templateData)
+}
+
+func NotificationEmail(to string, locale models.Locale, title string, body string) (*models.Email, error) {
 	templateData := struct {
-		ResetLink string
+		Title string
+		Body  string
 	}{
-		ResetLink: fmt.Sprintf("%s/reset-password/%s", baseUrl, resetCode),
+		Title: title,
+		Body:  body,
 	}
 
-	err = e.sendEmail(to, "Réinitialiser votre mot de passe", t, templateData)
+	email, err := newEmail(to, locale, TemplateNotification, templateData)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
-}
+	email.Subject = title
 
-func (e *emailManager) SendUpdateEmail(to []string, email string) error {
-	t, err := template.ParseFiles("templates/emails/update-profile.html")
+	return email, nil
+}
 
-	if err != nil {
-		return err
-	}
+type DigestEntry struct {
+	Title string
+	Body  string
+}
 
+func DigestEmail(to string, locale models.Locale, frequency models.EmailFrequency, entries []DigestEntry) (*models.Email, error) {
 	templateData := struct {
-		NewEmail string
+		Frequency models.EmailFrequency
+		Entries   []DigestEntry
 	}{
-		NewEmail: email,
-	}
-
-	err = e.sendEmail(to, "Votre nouvel email!", t, templateData)
-	if err != nil {
-		return err
+		Frequency: frequency,
+		Entries:   entries,
 	}
 
-	return nil
+	return newEmail(to, locale, TemplateDigest, templateData)
 }
@@ -0,0 +1,295 @@
+package email
+
+import (
+	"bufio"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/esgi-challenge/backend/config"
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// Body of the message once the quoted-printable encoding is removed
+func decodeBody(t *testing.T, raw []byte) (*mail.Message, string) {
+	message, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(quotedprintable.NewReader(message.Body))
+	assert.NoError(t, err)
+
+	return message, string(body)
+}
+
+func TestSendCapture(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	transport, err := NewCaptureTransport(dir)
+	assert.NoError(t, err)
+
+	manager := InitEmailManager("noreply@studies.fr", transport)
+
+	email, err := ResetEmail("https://studies.fr/", "john@doe.fr", models.LOCALE_FR, "code")
+	assert.NoError(t, err)
+
+	assert.NoError(t, manager.Send(email))
+
+	messages := transport.Messages()
+	assert.Len(t, messages, 1)
+	assert.Equal(t, []string{"john@doe.fr"}, messages[0].To)
+
+	message, body := decodeBody(t, messages[0].Raw)
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Réinitialiser votre mot de passe", subject)
+	assert.Equal(t, "noreply@studies.fr", message.Header.Get("From"))
+	assert.Contains(t, body, "https://studies.fr/reset-password/code")
+
+	files, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+	assert.Equal(t, ".eml", filepath.Ext(files[0].Name()))
+
+	transport.Reset()
+	assert.Empty(t, transport.Messages())
+}
+
+func TestSendLocalized(t *testing.T) {
+	t.Parallel()
+
+	transport, err := NewCaptureTransport("")
+	assert.NoError(t, err)
+
+	email, err := ResetEmail("https://studies.fr", "john@doe.fr", models.LOCALE_EN, "code")
+	assert.NoError(t, err)
+	assert.Equal(t, "Reset your password", email.Subject)
+
+	assert.NoError(t, InitEmailManager("noreply@studies.fr", transport).Send(email))
+
+	message := transport.Messages()[0]
+	assert.Equal(t, "Reset your password", message.Subject)
+
+	_, body := decodeBody(t, message.Raw)
+	assert.Contains(t, body, "Reset your password")
+	assert.NotContains(t, body, "Réinitialiser")
+}
+
+func TestUnknownLocaleUsesDefault(t *testing.T) {
+	t.Parallel()
+
+	email, err := UpdateEmail("john@doe.fr", "de", "new@doe.fr")
+	assert.NoError(t, err)
+	assert.Equal(t, models.DefaultLocale, email.Locale)
+	assert.Equal(t, subjects[models.DefaultLocale][TemplateUpdateProfile], email.Subject)
+}
+
+// Every locale must be able to send every email
+func TestLocalesHaveEveryTemplate(t *testing.T) {
+	t.Parallel()
+
+	for locale, set := range templates {
+		for _, name := range []string{TemplateInvitation, TemplateResetPassword, TemplateUpdateProfile, TemplateDigest} {
+			assert.NotNil(t, set.Lookup(name+".html"), "%s template for %s", name, locale)
+			assert.NotEmpty(t, subjects[locale][name], "%s subject for %s", name, locale)
+		}
+
+		assert.NotNil(t, set.Lookup(TemplateNotification+".html"), "%s template for %s", TemplateNotification, locale)
+	}
+}
+
+func TestSendNotificationUsesTitle(t *testing.T) {
+	t.Parallel()
+
+	transport, err := NewCaptureTransport("")
+	assert.NoError(t, err)
+
+	email, err := NotificationEmail("john@doe.fr", models.LOCALE_EN, "New note", "Maths: 15")
+	assert.NoError(t, err)
+
+	assert.NoError(t, InitEmailManager("noreply@studies.fr", transport).Send(email))
+
+	message := transport.Messages()[0]
+	assert.Equal(t, "New note", message.Subject)
+
+	_, body := decodeBody(t, message.Raw)
+	assert.Contains(t, body, "Maths: 15")
+}
+
+func TestSendDigest(t *testing.T) {
+	t.Parallel()
+
+	transport, err := NewCaptureTransport("")
+	assert.NoError(t, err)
+
+	email, err := DigestEmail("john@doe.fr", models.LOCALE_EN, models.EMAIL_WEEKLY, []DigestEntry{
+		{Title: "New note", Body: "Maths: 15"},
+		{Title: "Course updated", Body: "Physics"},
+	})
+	assert.NoError(t, err)
+
+	assert.NoError(t, InitEmailManager("noreply@studies.fr", transport).Send(email))
+
+	message := transport.Messages()[0]
+	assert.Equal(t, "Your Studies digest", message.Subject)
+
+	_, body := decodeBody(t, message.Raw)
+	assert.Contains(t, body, "this week")
+	assert.Contains(t, body, "Maths: 15")
+	assert.Contains(t, body, "Physics")
+}
+
+func TestSendEscapesData(t *testing.T) {
+	t.Parallel()
+
+	transport, err := NewCaptureTransport("")
+	assert.NoError(t, err)
+
+	email, err := InvitationEmail("https://studies.fr", "john@doe.fr", models.LOCALE_FR, "<script>", "Doe", "code")
+	assert.NoError(t, err)
+
+	assert.NoError(t, InitEmailManager("noreply@studies.fr", transport).Send(email))
+
+	_, body := decodeBody(t, transport.Messages()[0].Raw)
+	assert.NotContains(t, body, "<script>")
+	assert.Contains(t, body, "https://studies.fr/invite/code")
+}
+
+func TestSendUnknownTemplate(t *testing.T) {
+	t.Parallel()
+
+	transport, err := NewCaptureTransport("")
+	assert.NoError(t, err)
+
+	email, err := newEmail("john@doe.fr", models.LOCALE_EN, "unknown", struct{}{})
+	assert.NoError(t, err)
+
+	assert.Error(t, InitEmailManager("noreply@studies.fr", transport).Send(email))
+	assert.Empty(t, transport.Messages())
+}
+
+// Minimal SMTP server accepting a single email without authentication
+func fakeSmtp(t *testing.T) (string, chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() })
+
+	received := make(chan string, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		reader := bufio.NewReader(conn)
+		conn.Write([]byte("220 localhost ESMTP\r\n"))
+
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+
+			switch command := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(command, "EHLO"):
+				conn.Write([]byte("250 localhost\r\n"))
+			case strings.HasPrefix(command, "DATA"):
+				conn.Write([]byte("354 Go ahead\r\n"))
+
+				var data strings.Builder
+				for {
+					line, err := reader.ReadString('\n')
+					if err != nil || line == ".\r\n" {
+						break
+					}
+					data.WriteString(line)
+				}
+
+				received <- data.String()
+				conn.Write([]byte("250 OK\r\n"))
+			case strings.HasPrefix(command, "QUIT"):
+				conn.Write([]byte("221 Bye\r\n"))
+				return
+			default:
+				conn.Write([]byte("250 OK\r\n"))
+			}
+		}
+	}()
+
+	return listener.Addr().String(), received
+}
+
+func TestSmtpTransport(t *testing.T) {
+	t.Parallel()
+
+	address, received := fakeSmtp(t)
+	host, port, err := net.SplitHostPort(address)
+	assert.NoError(t, err)
+
+	transport, err := NewSmtpTransport(host, port, "", "", TLS_NONE)
+	assert.NoError(t, err)
+
+	email, err := UpdateEmail("john@doe.fr", "", "new@doe.fr")
+	assert.NoError(t, err)
+
+	assert.NoError(t, InitEmailManager("noreply@studies.fr", transport).Send(email))
+
+	_, body := decodeBody(t, []byte(<-received))
+	assert.Contains(t, body, "new@doe.fr")
+}
+
+func TestSmtpTransportUnknownTlsMode(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewSmtpTransport("localhost", "25", "", "", "ssl")
+	assert.Error(t, err)
+}
+
+func TestNewTransport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("capture without SMTP settings", func(t *testing.T) {
+		transport, err := NewTransport(&config.Config{EmailTransport: "capture"})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, transport)
+	})
+
+	t.Run("smtp without SMTP settings", func(t *testing.T) {
+		_, err := NewTransport(&config.Config{EmailTransport: "smtp"})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("smtp", func(t *testing.T) {
+		transport, err := NewTransport(&config.Config{
+			EmailTransport: "smtp",
+			Smtp: config.SMTPConfig{
+				Username: "user",
+				Password: "password",
+				Host:     "localhost",
+				Port:     "587",
+				TlsMode:  "starttls",
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, transport)
+	})
+
+	t.Run("unknown transport", func(t *testing.T) {
+		_, err := NewTransport(&config.Config{EmailTransport: "sendmail"})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,80 @@
+package email
+
+import (
+	"fmt"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/esgi-challenge/backend/internal/models"
+)
+
+type notificationText struct {
+	title *texttemplate.Template
+	body  *texttemplate.Template
+}
+
+func notificationTexts(texts map[models.NotificationMessageKey][2]string) map[models.NotificationMessageKey]notificationText {
+	parsed := make(map[models.NotificationMessageKey]notificationText, len(texts))
+
+	for key, text := range texts {
+		parsed[key] = notificationText{
+			title: texttemplate.Must(texttemplate.New(string(key) + ".title").Option("missingkey=zero").Parse(text[0])),
+			body:  texttemplate.Must(texttemplate.New(string(key) + ".body").Option("missingkey=zero").Parse(text[1])),
+		}
+	}
+
+	return parsed
+}
+
+// Title and body of the notifications by locale, every locale has the same keys. They are
+// used in-app, by push and by email
+var notifications = map[models.Locale]map[models.NotificationMessageKey]notificationText{
+	models.LOCALE_FR: notificationTexts(map[models.NotificationMessageKey][2]string{
+		models.NOTIFICATION_MESSAGE_NOTE_CREATED:          {"Nouvelle note", "{{.project}} : {{.value}}"},
+		models.NOTIFICATION_MESSAGE_NOTE_UPDATED:          {"Note modifiée", "{{.project}} : {{.value}}"},
+		models.NOTIFICATION_MESSAGE_SCHEDULE_CREATED:      {"Nouveau cours planifié", "{{if .course}}{{.course}}, {{end}}{{.time}}"},
+		models.NOTIFICATION_MESSAGE_SCHEDULE_UPDATED:      {"Cours modifié", "{{if .course}}{{.course}}, {{end}}{{.time}}"},
+		models.NOTIFICATION_MESSAGE_SCHEDULE_CANCELLED:    {"Cours annulé", "{{if .course}}{{.course}}, {{end}}{{.time}}"},
+		models.NOTIFICATION_MESSAGE_PROJECT_CREATED:       {"Nouveau projet", "{{.project}}, à rendre le {{.endDate}}"},
+		models.NOTIFICATION_MESSAGE_INFORMATIONS:          {"{{.title}}", "{{.description}}"},
+		models.NOTIFICATION_MESSAGE_INFORMATIONS_REMINDER: {"Rappel : {{.title}}", "{{.description}}"},
+		models.NOTIFICATION_MESSAGE_EVENT_CREATED:         {"{{.title}}", "Le {{.startAt}}"},
+		models.NOTIFICATION_MESSAGE_EVENT_PROMOTED:        {"Une place s'est libérée pour {{.title}}", "Le {{.startAt}}"},
+		models.NOTIFICATION_MESSAGE_CHAT_MESSAGE:          {"{{.sender}}", "{{.content}}"},
+		models.NOTIFICATION_MESSAGE_CHAT_ATTACHMENT:       {"{{.sender}}", "A envoyé une pièce jointe"},
+	}),
+	models.LOCALE_EN: notificationTexts(map[models.NotificationMessageKey][2]string{
+		models.NOTIFICATION_MESSAGE_NOTE_CREATED:          {"New note", "{{.project}}: {{.value}}"},
+		models.NOTIFICATION_MESSAGE_NOTE_UPDATED:          {"Note updated", "{{.project}}: {{.value}}"},
+		models.NOTIFICATION_MESSAGE_SCHEDULE_CREATED:      {"New course scheduled", "{{if .course}}{{.course}}, {{end}}{{.time}}"},
+		models.NOTIFICATION_MESSAGE_SCHEDULE_UPDATED:      {"Course updated", "{{if .course}}{{.course}}, {{end}}{{.time}}"},
+		models.NOTIFICATION_MESSAGE_SCHEDULE_CANCELLED:    {"Course cancelled", "{{if .course}}{{.course}}, {{end}}{{.time}}"},
+		models.NOTIFICATION_MESSAGE_PROJECT_CREATED:       {"New project", "{{.project}}, due {{.endDate}}"},
+		models.NOTIFICATION_MESSAGE_INFORMATIONS:          {"{{.title}}", "{{.description}}"},
+		models.NOTIFICATION_MESSAGE_INFORMATIONS_REMINDER: {"Reminder: {{.title}}", "{{.description}}"},
+		models.NOTIFICATION_MESSAGE_EVENT_CREATED:         {"{{.title}}", "On {{.startAt}}"},
+		models.NOTIFICATION_MESSAGE_EVENT_PROMOTED:        {"A place is free for {{.title}}", "On {{.startAt}}"},
+		models.NOTIFICATION_MESSAGE_CHAT_MESSAGE:          {"{{.sender}}", "{{.content}}"},
+		models.NOTIFICATION_MESSAGE_CHAT_ATTACHMENT:       {"{{.sender}}", "Sent an attachment"},
+	}),
+}
+
+// Title and body of the notification in the locale, the default one for unknown locales
+func RenderNotification(locale models.Locale, key models.NotificationMessageKey, params map[string]string) (string, string, error) {
+	text, ok := notifications[localeOrDefault(locale)][key]
+	if !ok {
+		return "", "", fmt.Errorf("unknown notification message %q", key)
+	}
+
+	var title, body strings.Builder
+
+	if err := text.title.Execute(&title, params); err != nil {
+		return "", "", err
+	}
+
+	if err := text.body.Execute(&body, params); err != nil {
+		return "", "", err
+	}
+
+	return title.String(), body.String(), nil
+}
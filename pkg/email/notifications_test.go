@@ -0,0 +1,55 @@
+package email
+
+import (
+	"testing"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// Every locale must be able to render every notification
+func TestLocalesHaveEveryNotification(t *testing.T) {
+	t.Parallel()
+
+	for key := range notifications[models.DefaultLocale] {
+		for locale, texts := range notifications {
+			assert.Contains(t, texts, key, "%s notification for %s", key, locale)
+		}
+	}
+
+	assert.Len(t, notifications[models.LOCALE_EN], len(notifications[models.LOCALE_FR]))
+}
+
+func TestRenderNotification(t *testing.T) {
+	t.Parallel()
+
+	params := map[string]string{"course": "Maths", "time": "02/01/2026 10:00"}
+
+	title, body, err := RenderNotification(models.LOCALE_EN, models.NOTIFICATION_MESSAGE_SCHEDULE_CANCELLED, params)
+	assert.NoError(t, err)
+	assert.Equal(t, "Course cancelled", title)
+	assert.Equal(t, "Maths, 02/01/2026 10:00", body)
+
+	title, _, err = RenderNotification(models.LOCALE_FR, models.NOTIFICATION_MESSAGE_SCHEDULE_CANCELLED, params)
+	assert.NoError(t, err)
+	assert.Equal(t, "Cours annulé", title)
+}
+
+func TestRenderNotificationMissingParam(t *testing.T) {
+	t.Parallel()
+
+	_, body, err := RenderNotification(models.LOCALE_EN, models.NOTIFICATION_MESSAGE_SCHEDULE_CREATED, map[string]string{"time": "02/01/2026 10:00"})
+	assert.NoError(t, err)
+	assert.Equal(t, "02/01/2026 10:00", body)
+}
+
+func TestRenderNotificationUnknown(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := RenderNotification(models.LOCALE_EN, "unknown", nil)
+	assert.Error(t, err)
+
+	title, _, err := RenderNotification("de", models.NOTIFICATION_MESSAGE_NOTE_CREATED, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Nouvelle note", title)
+}
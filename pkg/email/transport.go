@@ -0,0 +1,120 @@
+package email
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"time"
+)
+
+const (
+	dialTimeout = 10 * time.Second
+	// Whole SMTP conversation of a single email
+	sendTimeout = 30 * time.Second
+)
+
+type TlsMode string
+
+const (
+	// Plain connection upgraded with STARTTLS, usually on port 587
+	TLS_STARTTLS TlsMode = "starttls"
+	// TLS from the start, usually on port 465
+	TLS_IMPLICIT TlsMode = "tls"
+	// No encryption, for local servers only
+	TLS_NONE TlsMode = "none"
+)
+
+// Rendered email, Raw is the whole RFC 5322 message
+type Message struct {
+	From    string
+	To      []string
+	Subject string
+	Raw     []byte
+}
+
+type Transport interface {
+	Deliver(message *Message) error
+}
+
+type smtpTransport struct {
+	host     string
+	port     string
+	username string
+	password string
+	tlsMode  TlsMode
+}
+
+func NewSmtpTransport(host string, port string, username string, password string, tlsMode TlsMode) (Transport, error) {
+	switch tlsMode {
+	case TLS_STARTTLS, TLS_IMPLICIT, TLS_NONE:
+	default:
+		return nil, fmt.Errorf("unknown SMTP TLS mode %q", tlsMode)
+	}
+
+	return &smtpTransport{host: host, port: port, username: username, password: password, tlsMode: tlsMode}, nil
+}
+
+func (t *smtpTransport) dial() (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	address := net.JoinHostPort(t.host, t.port)
+
+	if t.tlsMode == TLS_IMPLICIT {
+		return tls.DialWithDialer(dialer, "tcp", address, &tls.Config{ServerName: t.host})
+	}
+
+	return dialer.Dial("tcp", address)
+}
+
+func (t *smtpTransport) Deliver(message *Message) error {
+	conn, err := t.dial()
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(sendTimeout))
+
+	client, err := smtp.NewClient(conn, t.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if t.tlsMode == TLS_STARTTLS {
+		if err := client.StartTLS(&tls.Config{ServerName: t.host}); err != nil {
+			return err
+		}
+	}
+
+	// PlainAuth refuses to send the password over a plain connection to another host
+	if t.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", t.username, t.password, t.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(message.From); err != nil {
+		return err
+	}
+
+	for _, to := range message.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(message.Raw); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
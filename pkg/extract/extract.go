@@ -0,0 +1,88 @@
+package extract
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// Postgres tsvector are limited to 1MB, extracted text is cut before that
+const maxLength = 512 * 1024
+
+// Only the first pages of big PDFs are indexed
+const maxPages = 50
+
+var ErrUnsupported = errors.New("extract: unsupported content type")
+
+type Extractor interface {
+	Extract(ctx context.Context, contentType string, file []byte) (string, error)
+}
+
+type extractor struct{}
+
+// Plain text is read as is, PDFs text is extracted with poppler's pdftotext
+func NewExtractor() Extractor {
+	return &extractor{}
+}
+
+func (e *extractor) Extract(ctx context.Context, contentType string, file []byte) (string, error) {
+	switch {
+	case strings.HasPrefix(contentType, "text/plain"):
+		return clean(string(file)), nil
+	case contentType == "application/pdf":
+		text, err := extractPdf(ctx, file)
+		if err != nil {
+			return "", err
+		}
+
+		return clean(text), nil
+	default:
+		return "", ErrUnsupported
+	}
+}
+
+// Postgres refuses null bytes and invalid UTF-8 in text columns
+func clean(text string) string {
+	text = strings.ToValidUTF8(text, "")
+	text = strings.ReplaceAll(text, "\x00", "")
+
+	if len(text) > maxLength {
+		text = text[:maxLength]
+		for !utf8.ValidString(text) {
+			text = text[:len(text)-1]
+		}
+	}
+
+	return text
+}
+
+func extractPdf(ctx context.Context, file []byte) (string, error) {
+	dir, err := os.MkdirTemp("", "extract")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "document.pdf")
+
+	if err := os.WriteFile(input, file, 0600); err != nil {
+		return "", err
+	}
+
+	var stderr strings.Builder
+
+	cmd := exec.CommandContext(ctx, "pdftotext", "-enc", "UTF-8", "-l", fmt.Sprint(maxPages), input, "-")
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("pdftotext: %v: %s", err, stderr.String())
+	}
+
+	return string(out), nil
+}
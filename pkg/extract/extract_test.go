@@ -0,0 +1,60 @@
+package extract
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractText(t *testing.T) {
+	t.Parallel()
+
+	extractor := NewExtractor()
+
+	t.Run("plain text", func(t *testing.T) {
+		text, err := extractor.Extract(context.Background(), "text/plain; charset=utf-8", []byte("Cours de réseau"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Cours de réseau", text)
+	})
+
+	t.Run("null bytes and invalid utf-8 are removed", func(t *testing.T) {
+		text, err := extractor.Extract(context.Background(), "text/plain; charset=utf-8", []byte("a\x00b\xffc"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, "abc", text)
+	})
+
+	t.Run("long text is cut on a rune", func(t *testing.T) {
+		file := []byte("a" + strings.Repeat("é", maxLength))
+
+		text, err := extractor.Extract(context.Background(), "text/plain; charset=utf-8", file)
+
+		assert.NoError(t, err)
+		assert.LessOrEqual(t, len(text), maxLength)
+		assert.True(t, utf8.ValidString(text))
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		text, err := extractor.Extract(context.Background(), "image/png", []byte("png"))
+
+		assert.ErrorIs(t, err, ErrUnsupported)
+		assert.Empty(t, text)
+	})
+}
+
+func TestExtractPdf(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("pdftotext"); err != nil {
+		t.Skip("pdftotext is not installed")
+	}
+
+	text, err := NewExtractor().Extract(context.Background(), "application/pdf", []byte("not a pdf"))
+	assert.Error(t, err)
+	assert.Empty(t, text)
+}
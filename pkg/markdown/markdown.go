@@ -0,0 +1,32 @@
+package markdown
+
+import (
+	"bytes"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+var (
+	// Raw HTML of the source is not rendered
+	converter = goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithRendererOptions(html.WithHardWraps()),
+	)
+
+	// Links are checked again after rendering, the renderer keeps unsafe schemes
+	policy = bluemonday.UGCPolicy().AddTargetBlankToFullyQualifiedLinks(true)
+)
+
+// Render the Markdown source as HTML safe to insert in a page
+func ToSafeHtml(source string) (string, error) {
+	var buffer bytes.Buffer
+
+	if err := converter.Convert([]byte(source), &buffer); err != nil {
+		return "", err
+	}
+
+	return policy.Sanitize(buffer.String()), nil
+}
@@ -0,0 +1,41 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToSafeHtml(t *testing.T) {
+	t.Parallel()
+
+	result, err := ToSafeHtml("# Exams\n\n**Bring** your *student card*\n\n- pen\n- ruler")
+	assert.NoError(t, err)
+
+	assert.Contains(t, result, "<h1")
+	assert.Contains(t, result, "<strong>Bring</strong>")
+	assert.Contains(t, result, "<em>student card</em>")
+	assert.Contains(t, result, "<li>ruler</li>")
+}
+
+func TestToSafeHtmlDropsRawHtml(t *testing.T) {
+	t.Parallel()
+
+	result, err := ToSafeHtml("Hello <script>alert(1)</script> <img src=x onerror=alert(1)>")
+	assert.NoError(t, err)
+
+	assert.NotContains(t, result, "<script")
+	assert.NotContains(t, result, "onerror")
+	assert.Contains(t, result, "Hello")
+}
+
+func TestToSafeHtmlDropsUnsafeLinks(t *testing.T) {
+	t.Parallel()
+
+	result, err := ToSafeHtml("[click](javascript:alert(1)) [site](https://studies.fr)")
+	assert.NoError(t, err)
+
+	assert.NotContains(t, result, "javascript:")
+	assert.Contains(t, result, `href="https://studies.fr"`)
+	assert.Contains(t, result, `rel="nofollow noopener"`)
+}
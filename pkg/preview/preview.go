@@ -0,0 +1,131 @@
+package preview
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	_ "image/gif"
+	_ "image/png"
+)
+
+// Biggest side of a generated preview, in pixels
+const maxSize = 320
+
+const jpegQuality = 80
+
+var ErrUnsupported = errors.New("preview: unsupported content type")
+
+type Generator interface {
+	// Returns a JPEG preview of the file
+	Generate(ctx context.Context, contentType string, file []byte) ([]byte, error)
+}
+
+type generator struct{}
+
+// Images are resized in process, PDFs first page is rendered with poppler's pdftoppm
+func NewGenerator() Generator {
+	return &generator{}
+}
+
+func (g *generator) Generate(ctx context.Context, contentType string, file []byte) ([]byte, error) {
+	switch contentType {
+	case "image/png", "image/jpeg", "image/gif":
+		src, _, err := image.Decode(bytes.NewReader(file))
+		if err != nil {
+			return nil, err
+		}
+
+		return encode(thumbnail(src))
+	case "application/pdf":
+		return renderPdf(ctx, file)
+	default:
+		return nil, ErrUnsupported
+	}
+}
+
+func encode(img image.Image) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Box filter downscale keeping the aspect ratio, transparency is flattened on white
+func thumbnail(src image.Image) image.Image {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	dstWidth, dstHeight := width, height
+	if width > maxSize || height > maxSize {
+		if width >= height {
+			dstWidth, dstHeight = maxSize, max(1, height*maxSize/width)
+		} else {
+			dstWidth, dstHeight = max(1, width*maxSize/height), maxSize
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+
+	for y := 0; y < dstHeight; y++ {
+		y0 := bounds.Min.Y + y*height/dstHeight
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*height/dstHeight)
+
+		for x := 0; x < dstWidth; x++ {
+			x0 := bounds.Min.X + x*width/dstWidth
+			x1 := max(x0+1, bounds.Min.X+(x+1)*width/dstWidth)
+
+			var r, g, b, count uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r += uint64(pr + 0xffff - pa)
+					g += uint64(pg + 0xffff - pa)
+					b += uint64(pb + 0xffff - pa)
+					count++
+				}
+			}
+
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / count),
+				G: uint16(g / count),
+				B: uint16(b / count),
+				A: 0xffff,
+			})
+		}
+	}
+
+	return dst
+}
+
+func renderPdf(ctx context.Context, file []byte) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "preview")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "document.pdf")
+	output := filepath.Join(dir, "preview")
+
+	if err := os.WriteFile(input, file, 0600); err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, "pdftoppm", "-jpeg", "-singlefile", "-f", "1", "-l", "1", "-scale-to", fmt.Sprint(maxSize), input, output)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("pdftoppm: %v: %s", err, out)
+	}
+
+	return os.ReadFile(output + ".jpg")
+}
@@ -0,0 +1,116 @@
+package preview
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func pngFile(t *testing.T, width int, height int, c color.Color) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, c)
+		}
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+
+	return buf.Bytes()
+}
+
+func TestGenerateImage(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator()
+
+	t.Run("landscape image is resized", func(t *testing.T) {
+		file := pngFile(t, 1280, 640, color.RGBA{R: 255, A: 255})
+
+		preview, err := generator.Generate(context.Background(), "image/png", file)
+		assert.NoError(t, err)
+
+		img, err := jpeg.Decode(bytes.NewReader(preview))
+		assert.NoError(t, err)
+		assert.Equal(t, maxSize, img.Bounds().Dx())
+		assert.Equal(t, maxSize/2, img.Bounds().Dy())
+
+		r, g, b, _ := img.At(10, 10).RGBA()
+		assert.Greater(t, r>>8, uint32(240))
+		assert.Less(t, g>>8, uint32(20))
+		assert.Less(t, b>>8, uint32(20))
+	})
+
+	t.Run("portrait image is resized", func(t *testing.T) {
+		file := pngFile(t, 100, 1000, color.Black)
+
+		preview, err := generator.Generate(context.Background(), "image/png", file)
+		assert.NoError(t, err)
+
+		img, err := jpeg.Decode(bytes.NewReader(preview))
+		assert.NoError(t, err)
+		assert.Equal(t, 32, img.Bounds().Dx())
+		assert.Equal(t, maxSize, img.Bounds().Dy())
+	})
+
+	t.Run("small image keeps its size", func(t *testing.T) {
+		file := pngFile(t, 20, 10, color.White)
+
+		preview, err := generator.Generate(context.Background(), "image/png", file)
+		assert.NoError(t, err)
+
+		img, err := jpeg.Decode(bytes.NewReader(preview))
+		assert.NoError(t, err)
+		assert.Equal(t, 20, img.Bounds().Dx())
+		assert.Equal(t, 10, img.Bounds().Dy())
+	})
+
+	t.Run("transparency is flattened on white", func(t *testing.T) {
+		file := pngFile(t, 10, 10, color.Transparent)
+
+		preview, err := generator.Generate(context.Background(), "image/png", file)
+		assert.NoError(t, err)
+
+		img, err := jpeg.Decode(bytes.NewReader(preview))
+		assert.NoError(t, err)
+
+		r, g, b, _ := img.At(5, 5).RGBA()
+		assert.Greater(t, r>>8, uint32(240))
+		assert.Greater(t, g>>8, uint32(240))
+		assert.Greater(t, b>>8, uint32(240))
+	})
+
+	t.Run("invalid image", func(t *testing.T) {
+		preview, err := generator.Generate(context.Background(), "image/png", []byte("not an image"))
+		assert.Error(t, err)
+		assert.Nil(t, preview)
+	})
+}
+
+func TestGenerateUnsupported(t *testing.T) {
+	t.Parallel()
+
+	preview, err := NewGenerator().Generate(context.Background(), "text/plain; charset=utf-8", []byte("hello"))
+	assert.ErrorIs(t, err, ErrUnsupported)
+	assert.Nil(t, preview)
+}
+
+func TestGeneratePdf(t *testing.T) {
+	t.Parallel()
+
+	if _, err := exec.LookPath("pdftoppm"); err != nil {
+		t.Skip("pdftoppm is not installed")
+	}
+
+	preview, err := NewGenerator().Generate(context.Background(), "application/pdf", []byte("not a pdf"))
+	assert.Error(t, err)
+	assert.Nil(t, preview)
+}
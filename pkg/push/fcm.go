@@ -0,0 +1,186 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+const fcmScope = "https://www.googleapis.com/auth/firebase.messaging"
+
+const fcmTimeout = 10 * time.Second
+
+// HTTP v1 takes a single token per message, they are sent concurrently
+const fcmConcurrency = 10
+
+// Error codes meaning the token will never be valid again
+var fcmInvalidTokenErrors = map[string]bool{
+	"UNREGISTERED":       true,
+	"INVALID_ARGUMENT":   true,
+	"SENDER_ID_MISMATCH": true,
+}
+
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification fcmNotification   `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type fcmRequest struct {
+	Message fcmMessage `json:"message"`
+}
+
+type fcmErrorDetail struct {
+	Type      string `json:"@type"`
+	ErrorCode string `json:"errorCode"`
+}
+
+type fcmErrorResponse struct {
+	Error struct {
+		Code    int              `json:"code"`
+		Message string           `json:"message"`
+		Status  string           `json:"status"`
+		Details []fcmErrorDetail `json:"details"`
+	} `json:"error"`
+}
+
+// The FCM error code of the details, the generic status otherwise
+func (r *fcmErrorResponse) errorCode() string {
+	for _, detail := range r.Error.Details {
+		if strings.HasSuffix(detail.Type, "google.firebase.fcm.v1.FcmError") && detail.ErrorCode != "" {
+			return detail.ErrorCode
+		}
+	}
+
+	return r.Error.Status
+}
+
+type fcmProvider struct {
+	url    string
+	client *http.Client
+}
+
+// Service account credentials read from the file, the application default credentials when
+// no file is given
+func NewFcmTokenSource(ctx context.Context, credentialsFile string) (oauth2.TokenSource, error) {
+	if credentialsFile == "" {
+		return google.DefaultTokenSource(ctx, fcmScope)
+	}
+
+	credentials, err := os.ReadFile(credentialsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := google.JWTConfigFromJSON(credentials, fcmScope)
+	if err != nil {
+		return nil, err
+	}
+
+	return config.TokenSource(ctx), nil
+}
+
+// Provider talking to the FCM HTTP v1 API of the project, or any server speaking the same
+// protocol at endpoint
+func NewFcmProvider(endpoint string, projectId string, tokenSource oauth2.TokenSource) Provider {
+	return &fcmProvider{
+		url: fmt.Sprintf("%s/v1/projects/%s/messages:send", strings.TrimRight(endpoint, "/"), projectId),
+		client: &http.Client{
+			Timeout:   fcmTimeout,
+			Transport: &oauth2.Transport{Source: tokenSource},
+		},
+	}
+}
+
+// Every token is tried, the errors other than invalid tokens are joined
+func (p *fcmProvider) Send(ctx context.Context, tokens []string, message *Message) (*Result, error) {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var errs []error
+
+	result := &Result{}
+	slots := make(chan struct{}, fcmConcurrency)
+
+	for _, token := range tokens {
+		wg.Add(1)
+		slots <- struct{}{}
+
+		go func(token string) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			invalid, err := p.send(ctx, token, message)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if invalid {
+				result.InvalidTokens = append(result.InvalidTokens, token)
+			}
+
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}(token)
+	}
+
+	wg.Wait()
+
+	return result, errors.Join(errs...)
+}
+
+// Whether the token is invalid is returned, it is not an error
+func (p *fcmProvider) send(ctx context.Context, token string, message *Message) (bool, error) {
+	body, err := json.Marshal(&fcmRequest{
+		Message: fcmMessage{
+			Token:        token,
+			Notification: fcmNotification{Title: message.Title, Body: message.Body},
+			Data:         message.Data,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusOK {
+		return false, nil
+	}
+
+	detail, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+
+	var response fcmErrorResponse
+	if json.Unmarshal(detail, &response) == nil && fcmInvalidTokenErrors[response.errorCode()] {
+		return true, nil
+	}
+
+	return false, fmt.Errorf("fcm: %s: %s", res.Status, bytes.TrimSpace(detail))
+}
@@ -0,0 +1,117 @@
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+// Minimal FCM HTTP v1 server, tokens starting with "invalid" are not registered and the
+// ones starting with "bad" are rejected as invalid arguments
+func fakeFcm(t *testing.T, accessToken string) (*httptest.Server, *[]fcmRequest) {
+	var mu sync.Mutex
+	var requests []fcmRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/projects/project/messages:send" {
+			http.NotFound(w, r)
+			return
+		}
+
+		if r.Header.Get("Authorization") != "Bearer "+accessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error": {"code": 401, "status": "UNAUTHENTICATED"}}`))
+			return
+		}
+
+		var req fcmRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		requests = append(requests, req)
+		mu.Unlock()
+
+		switch {
+		case strings.HasPrefix(req.Message.Token, "invalid"):
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"code": 404, "status": "NOT_FOUND", "details": [{"@type": "type.googleapis.com/google.firebase.fcm.v1.FcmError", "errorCode": "UNREGISTERED"}]}}`))
+		case strings.HasPrefix(req.Message.Token, "bad"):
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error": {"code": 400, "status": "INVALID_ARGUMENT"}}`))
+		case strings.HasPrefix(req.Message.Token, "unavailable"):
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error": {"code": 503, "status": "UNAVAILABLE"}}`))
+		default:
+			w.Write([]byte(`{"name": "projects/project/messages/1"}`))
+		}
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func staticTokenSource(accessToken string) oauth2.TokenSource {
+	return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken})
+}
+
+func TestFcmSend(t *testing.T) {
+	t.Parallel()
+
+	server, requests := fakeFcm(t, "access")
+	provider := NewFcmProvider(server.URL, "project", staticTokenSource("access"))
+
+	result, err := provider.Send(context.Background(), []string{"first", "invalid-second", "bad-third"}, &Message{
+		Title: "title",
+		Body:  "body",
+		Data:  map[string]string{"type": "chat"},
+	})
+
+	assert.NoError(t, err)
+	sort.Strings(result.InvalidTokens)
+	assert.Equal(t, []string{"bad-third", "invalid-second"}, result.InvalidTokens)
+	assert.Len(t, *requests, 3)
+
+	for _, request := range *requests {
+		assert.Equal(t, "title", request.Message.Notification.Title)
+		assert.Equal(t, "body", request.Message.Notification.Body)
+		assert.Equal(t, "chat", request.Message.Data["type"])
+	}
+}
+
+func TestFcmSendError(t *testing.T) {
+	t.Parallel()
+
+	server, requests := fakeFcm(t, "access")
+	provider := NewFcmProvider(server.URL, "project", staticTokenSource("access"))
+
+	result, err := provider.Send(context.Background(), []string{"unavailable", "invalid", "first"}, &Message{Title: "title"})
+
+	// The other tokens are still tried
+	assert.Error(t, err)
+	assert.Equal(t, []string{"invalid"}, result.InvalidTokens)
+	assert.Len(t, *requests, 3)
+}
+
+func TestFcmSendUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	server, _ := fakeFcm(t, "access")
+	provider := NewFcmProvider(server.URL, "project", staticTokenSource("wrong"))
+
+	result, err := provider.Send(context.Background(), []string{"first"}, &Message{Title: "title"})
+
+	assert.Error(t, err)
+	assert.Empty(t, result.InvalidTokens)
+}
@@ -0,0 +1,30 @@
+package push
+
+import "context"
+
+type Message struct {
+	Title string
+	Body  string
+	// Given as is to the app, to open the conversation or the notification
+	Data map[string]string
+}
+
+type Result struct {
+	// Tokens the provider doesn't know anymore, their devices must be forgotten
+	InvalidTokens []string
+}
+
+type Provider interface {
+	Send(ctx context.Context, tokens []string, message *Message) (*Result, error)
+}
+
+type noopProvider struct{}
+
+// Provider used when push is not configured, the messages are dropped
+func NewNoopProvider() Provider {
+	return noopProvider{}
+}
+
+func (noopProvider) Send(ctx context.Context, tokens []string, message *Message) (*Result, error) {
+	return &Result{}, nil
+}
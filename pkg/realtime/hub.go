@@ -0,0 +1,93 @@
+package realtime
+
+import "sync"
+
+// Payloads waiting to be written to a slow subscriber before it gets dropped
+const subscriptionBuffer = 64
+
+type Subscription struct {
+	// Closed when the subscription ends, either unsubscribed or dropped for being too slow
+	C      chan []byte
+	topics []string
+}
+
+type Hub interface {
+	Subscribe(topics ...string) *Subscription
+	Unsubscribe(sub *Subscription)
+	// Delivers the payload to the subscribers of the topic, never blocks
+	Publish(topic string, payload []byte)
+}
+
+type localHub struct {
+	mu     sync.Mutex
+	topics map[string]map[*Subscription]bool
+}
+
+// Hub delivering to the subscribers of this process only
+func NewLocalHub() Hub {
+	return newLocalHub()
+}
+
+func newLocalHub() *localHub {
+	return &localHub{topics: make(map[string]map[*Subscription]bool)}
+}
+
+func (h *localHub) Subscribe(topics ...string) *Subscription {
+	sub := &Subscription{
+		C:      make(chan []byte, subscriptionBuffer),
+		topics: topics,
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, topic := range topics {
+		if _, ok := h.topics[topic]; !ok {
+			h.topics[topic] = make(map[*Subscription]bool)
+		}
+		h.topics[topic][sub] = true
+	}
+
+	return sub
+}
+
+func (h *localHub) Unsubscribe(sub *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.remove(sub)
+}
+
+// Must be called with the lock held
+func (h *localHub) remove(sub *Subscription) {
+	removed := false
+
+	for _, topic := range sub.topics {
+		if h.topics[topic][sub] {
+			removed = true
+			delete(h.topics[topic], sub)
+		}
+
+		if len(h.topics[topic]) == 0 {
+			delete(h.topics, topic)
+		}
+	}
+
+	// Only closed once, when it is still registered
+	if removed {
+		close(sub.C)
+	}
+}
+
+func (h *localHub) Publish(topic string, payload []byte) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.topics[topic] {
+		select {
+		case sub.C <- payload:
+		default:
+			h.remove(sub)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package realtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublish(t *testing.T) {
+	t.Parallel()
+
+	hub := NewLocalHub()
+
+	first := hub.Subscribe("channel:1")
+	second := hub.Subscribe("channel:1", "user:2")
+	other := hub.Subscribe("channel:2")
+
+	hub.Publish("channel:1", []byte("hello"))
+	hub.Publish("user:2", []byte("notification"))
+
+	assert.Equal(t, []byte("hello"), <-first.C)
+	assert.Equal(t, []byte("hello"), <-second.C)
+	assert.Equal(t, []byte("notification"), <-second.C)
+	assert.Empty(t, other.C)
+}
+
+func TestUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	hub := NewLocalHub()
+
+	sub := hub.Subscribe("channel:1", "user:2")
+	hub.Unsubscribe(sub)
+	hub.Unsubscribe(sub)
+
+	_, open := <-sub.C
+	assert.False(t, open)
+
+	assert.NotPanics(t, func() {
+		hub.Publish("channel:1", []byte("hello"))
+	})
+}
+
+func TestSlowSubscriberIsDropped(t *testing.T) {
+	t.Parallel()
+
+	hub := NewLocalHub()
+
+	slow := hub.Subscribe("channel:1")
+	fast := hub.Subscribe("channel:1")
+
+	for i := 0; i <= subscriptionBuffer; i++ {
+		hub.Publish("channel:1", []byte("hello"))
+		<-fast.C
+	}
+
+	for range slow.C {
+	}
+
+	hub.Publish("channel:1", []byte("hello"))
+	assert.Equal(t, []byte("hello"), <-fast.C)
+}
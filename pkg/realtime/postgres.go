@@ -0,0 +1,212 @@
+package realtime
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"gorm.io/gorm"
+)
+
+// Postgres channel carrying the events of every instance
+const notifyChannel = "realtime"
+
+// NOTIFY payloads are limited to 8000 bytes, larger events are stored and only their id is notified
+const maxNotifyPayload = 7000
+
+// Stored payloads are read right after their notification, older ones can be removed
+const storedPayloadExpiration = time.Minute
+
+// Events waiting to be notified before new ones are only delivered locally
+const notifyBuffer = 1024
+
+const reconnectDelay = 5 * time.Second
+
+type storedPayload struct {
+	ID        uint64 `gorm:"primaryKey"`
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+func (storedPayload) TableName() string {
+	return "realtime_payloads"
+}
+
+type notification struct {
+	instance string
+	topic    string
+	// Id of the stored payload, 0 when the payload is inline
+	ref     uint64
+	payload []byte
+}
+
+// Topics can't contain spaces or new lines and payloads must be text
+func (n notification) encode() string {
+	return fmt.Sprintf("%s %s %d\n%s", n.instance, n.topic, n.ref, n.payload)
+}
+
+func decodeNotification(raw string) (*notification, error) {
+	header, payload, found := strings.Cut(raw, "\n")
+	fields := strings.Fields(header)
+
+	if !found || len(fields) != 3 {
+		return nil, fmt.Errorf("invalid notification %q", header)
+	}
+
+	ref, err := strconv.ParseUint(fields[2], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &notification{
+		instance: fields[0],
+		topic:    fields[1],
+		ref:      ref,
+		payload:  []byte(payload),
+	}, nil
+}
+
+type postgresHub struct {
+	*localHub
+	db       *gorm.DB
+	dsn      string
+	instance string
+	pending  chan notification
+	logger   logger.Logger
+}
+
+// Hub shared by every instance using the database, events are delivered to the local subscribers
+// right away and sent to the other instances with NOTIFY
+func NewPostgresHub(db *gorm.DB, dsn string, logger logger.Logger) (Hub, error) {
+	h, err := newPostgresHub(db, dsn, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	go h.notify()
+	go h.listen()
+
+	return h, nil
+}
+
+func newPostgresHub(db *gorm.DB, dsn string, logger logger.Logger) (*postgresHub, error) {
+	if err := db.AutoMigrate(&storedPayload{}); err != nil {
+		return nil, err
+	}
+
+	return &postgresHub{
+		localHub: newLocalHub(),
+		db:       db,
+		dsn:      dsn,
+		instance: uuid.NewString(),
+		pending:  make(chan notification, notifyBuffer),
+		logger:   logger,
+	}, nil
+}
+
+func (h *postgresHub) Publish(topic string, payload []byte) {
+	h.localHub.Publish(topic, payload)
+
+	select {
+	case h.pending <- notification{instance: h.instance, topic: topic, payload: payload}:
+	default:
+		h.logger.Errorf("Realtime: notify queue full, %s event only delivered locally", topic)
+	}
+}
+
+// Send the queued events in order
+func (h *postgresHub) notify() {
+	for n := range h.pending {
+		if err := h.send(n); err != nil {
+			h.logger.Errorf("Realtime: notify %s: %v", n.topic, err)
+		}
+	}
+}
+
+func (h *postgresHub) send(n notification) error {
+	if len(n.encode()) > maxNotifyPayload {
+		stored := &storedPayload{Payload: n.payload}
+
+		if err := h.db.Create(stored).Error; err != nil {
+			return err
+		}
+
+		n.ref = stored.ID
+		n.payload = nil
+
+		if err := h.db.Where("created_at < ?", time.Now().Add(-storedPayloadExpiration)).Delete(&storedPayload{}).Error; err != nil {
+			h.logger.Errorf("Realtime: stored payloads cleanup: %v", err)
+		}
+	}
+
+	return h.db.Exec("SELECT pg_notify(?, ?)", notifyChannel, n.encode()).Error
+}
+
+// Listen on a dedicated connection, reconnecting until the process exits. Events sent
+// while disconnected are not delivered to this instance
+func (h *postgresHub) listen() {
+	for {
+		if err := h.listenConn(context.Background()); err != nil {
+			h.logger.Errorf("Realtime: listen: %v", err)
+		}
+
+		time.Sleep(reconnectDelay)
+	}
+}
+
+func (h *postgresHub) listenConn(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, h.dsn)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+
+	if _, err := conn.Exec(ctx, "LISTEN "+notifyChannel); err != nil {
+		return err
+	}
+
+	h.logger.Info("Realtime: Listening for events")
+
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := h.receive(n.Payload); err != nil {
+			h.logger.Errorf("Realtime: receive: %v", err)
+		}
+	}
+}
+
+// Deliver the event of another instance to the local subscribers
+func (h *postgresHub) receive(raw string) error {
+	n, err := decodeNotification(raw)
+	if err != nil {
+		return err
+	}
+
+	// Already delivered when it was published
+	if n.instance == h.instance {
+		return nil
+	}
+
+	if n.ref != 0 {
+		var stored storedPayload
+
+		if err := h.db.First(&stored, n.ref).Error; err != nil {
+			return err
+		}
+
+		n.payload = stored.Payload
+	}
+
+	h.localHub.Publish(n.topic, n.payload)
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package realtime
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func setupPostgresHub(t *testing.T) *postgresHub {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+
+	log := logger.NewLogger()
+	log.InitLogger()
+
+	h, err := newPostgresHub(db, "", log)
+	assert.NoError(t, err)
+
+	return h
+}
+
+func TestNotificationEncoding(t *testing.T) {
+	t.Parallel()
+
+	n := notification{instance: "a", topic: "chat:channel:1", payload: []byte("{\"content\":\"multi\\nline\"}\nraw")}
+
+	decoded, err := decodeNotification(n.encode())
+	assert.NoError(t, err)
+	assert.Equal(t, n.instance, decoded.instance)
+	assert.Equal(t, n.topic, decoded.topic)
+	assert.Equal(t, uint64(0), decoded.ref)
+	assert.Equal(t, n.payload, decoded.payload)
+
+	_, err = decodeNotification("no header")
+	assert.Error(t, err)
+
+	_, err = decodeNotification("a topic notanid\n{}")
+	assert.Error(t, err)
+}
+
+func TestPostgresHubPublish(t *testing.T) {
+	t.Parallel()
+
+	h := setupPostgresHub(t)
+	sub := h.Subscribe("topic")
+
+	h.Publish("topic", []byte("payload"))
+
+	// Delivered locally without waiting for the notification
+	assert.Equal(t, []byte("payload"), <-sub.C)
+
+	n := <-h.pending
+	assert.Equal(t, h.instance, n.instance)
+	assert.Equal(t, "topic", n.topic)
+	assert.Equal(t, []byte("payload"), n.payload)
+}
+
+func TestPostgresHubReceive(t *testing.T) {
+	t.Parallel()
+
+	h := setupPostgresHub(t)
+	sub := h.Subscribe("topic")
+
+	t.Run("own events are ignored", func(t *testing.T) {
+		err := h.receive(notification{instance: h.instance, topic: "topic", payload: []byte("own")}.encode())
+		assert.NoError(t, err)
+		assert.Len(t, sub.C, 0)
+	})
+
+	t.Run("inline payload", func(t *testing.T) {
+		err := h.receive(notification{instance: "other", topic: "topic", payload: []byte("inline")}.encode())
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("inline"), <-sub.C)
+	})
+
+	t.Run("stored payload", func(t *testing.T) {
+		large := []byte(strings.Repeat("x", maxNotifyPayload+1))
+		stored := &storedPayload{Payload: large}
+		assert.NoError(t, h.db.Create(stored).Error)
+
+		err := h.receive(notification{instance: "other", topic: "topic", ref: stored.ID}.encode())
+		assert.NoError(t, err)
+		assert.Equal(t, large, <-sub.C)
+	})
+
+	t.Run("missing stored payload", func(t *testing.T) {
+		err := h.receive(notification{instance: "other", topic: "topic", ref: 1000}.encode())
+		assert.Error(t, err)
+		assert.Len(t, sub.C, 0)
+	})
+
+	t.Run("invalid notification", func(t *testing.T) {
+		assert.Error(t, h.receive("invalid"))
+	})
+}
@@ -0,0 +1,225 @@
+package realtime
+
+import (
+	"sync"
+	"time"
+
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Every instance saves its online users this often
+const presenceHeartbeat = 30 * time.Second
+
+// Users of an instance that stopped saving them, a crashed one, are offline after this
+const presenceExpiration = 3 * presenceHeartbeat
+
+type presenceRow struct {
+	UserId   uint      `gorm:"primaryKey;autoIncrement:false"`
+	Instance string    `gorm:"primaryKey"`
+	SeenAt   time.Time `gorm:"index"`
+}
+
+func (presenceRow) TableName() string {
+	return "realtime_presences"
+}
+
+// Counts the open connections of each user, a user is online while at least one is open.
+// A shared presence also saves the users of this instance in the database so that the
+// ones connected to another instance are online too
+type Presence struct {
+	mu          sync.Mutex
+	connections map[uint]int
+	db          *gorm.DB
+	instance    string
+	logger      logger.Logger
+}
+
+// Presence of the connections of this process only
+func NewPresence() *Presence {
+	return &Presence{connections: make(map[uint]int)}
+}
+
+// Presence shared by every instance using the database
+func NewSharedPresence(db *gorm.DB, logger logger.Logger) (*Presence, error) {
+	p, err := newSharedPresence(db, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	go p.heartbeat()
+
+	return p, nil
+}
+
+func newSharedPresence(db *gorm.DB, logger logger.Logger) (*Presence, error) {
+	if err := db.AutoMigrate(&presenceRow{}); err != nil {
+		return nil, err
+	}
+
+	return &Presence{
+		connections: make(map[uint]int),
+		db:          db,
+		instance:    uuid.NewString(),
+		logger:      logger,
+	}, nil
+}
+
+// Returns true when it is the first connection of the user on every instance
+func (p *Presence) Connect(userId uint) bool {
+	p.mu.Lock()
+	p.connections[userId]++
+	first := p.connections[userId] == 1
+	p.mu.Unlock()
+
+	if !first || p.db == nil {
+		return first
+	}
+
+	elsewhere := p.onlineElsewhere(userId)
+
+	if err := p.save([]uint{userId}); err != nil {
+		p.logger.Errorf("Presence: connect %d: %v", userId, err)
+	}
+
+	return !elsewhere
+}
+
+// Returns true when it was the last connection of the user on every instance
+func (p *Presence) Disconnect(userId uint) bool {
+	p.mu.Lock()
+
+	if p.connections[userId] == 0 {
+		p.mu.Unlock()
+		return false
+	}
+
+	p.connections[userId]--
+	last := p.connections[userId] == 0
+
+	if last {
+		delete(p.connections, userId)
+	}
+	p.mu.Unlock()
+
+	if !last || p.db == nil {
+		return last
+	}
+
+	if err := p.db.Where("user_id = ? AND instance = ?", userId, p.instance).Delete(&presenceRow{}).Error; err != nil {
+		p.logger.Errorf("Presence: disconnect %d: %v", userId, err)
+	}
+
+	return !p.onlineElsewhere(userId)
+}
+
+func (p *Presence) IsOnline(userId uint) bool {
+	return p.OnlineUsers([]uint{userId})[userId]
+}
+
+// The users of the list with an open connection on any instance. When the database can't be
+// read only the connections of this instance are known
+func (p *Presence) OnlineUsers(userIds []uint) map[uint]bool {
+	online := make(map[uint]bool)
+	var others []uint
+
+	p.mu.Lock()
+	for _, id := range userIds {
+		if p.connections[id] > 0 {
+			online[id] = true
+		} else {
+			others = append(others, id)
+		}
+	}
+	p.mu.Unlock()
+
+	if len(others) == 0 || p.db == nil {
+		return online
+	}
+
+	var ids []uint
+
+	err := p.db.Model(&presenceRow{}).
+		Where("user_id IN ? AND seen_at > ?", others, time.Now().Add(-presenceExpiration)).
+		Distinct().Pluck("user_id", &ids).Error
+
+	if err != nil {
+		p.logger.Errorf("Presence: %v", err)
+		return online
+	}
+
+	for _, id := range ids {
+		online[id] = true
+	}
+
+	return online
+}
+
+// A failed read counts as offline elsewhere, the user is announced again rather than not at all
+func (p *Presence) onlineElsewhere(userId uint) bool {
+	var count int64
+
+	err := p.db.Model(&presenceRow{}).
+		Where("user_id = ? AND instance <> ? AND seen_at > ?", userId, p.instance, time.Now().Add(-presenceExpiration)).
+		Count(&count).Error
+
+	if err != nil {
+		p.logger.Errorf("Presence: %v", err)
+		return false
+	}
+
+	return count > 0
+}
+
+func (p *Presence) save(userIds []uint) error {
+	now := time.Now()
+	rows := []presenceRow{}
+
+	for _, id := range userIds {
+		rows = append(rows, presenceRow{UserId: id, Instance: p.instance, SeenAt: now})
+	}
+
+	return p.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "instance"}},
+		DoUpdates: clause.AssignmentColumns([]string{"seen_at"}),
+	}).Create(&rows).Error
+}
+
+func (p *Presence) heartbeat() {
+	ticker := time.NewTicker(presenceHeartbeat)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := p.beat(); err != nil {
+			p.logger.Errorf("Presence: heartbeat: %v", err)
+		}
+	}
+}
+
+// Save the users of this instance again, which also fixes the rows of a connection and a
+// disconnection saved out of order, and remove the ones of the instances that stopped
+func (p *Presence) beat() error {
+	p.mu.Lock()
+	userIds := []uint{}
+	for id := range p.connections {
+		userIds = append(userIds, id)
+	}
+	p.mu.Unlock()
+
+	stale := p.db.Where("instance = ?", p.instance)
+	if len(userIds) > 0 {
+		if err := p.save(userIds); err != nil {
+			return err
+		}
+
+		stale = stale.Where("user_id NOT IN ?", userIds)
+	}
+
+	if err := stale.Delete(&presenceRow{}).Error; err != nil {
+		return err
+	}
+
+	return p.db.Where("seen_at < ?", time.Now().Add(-presenceExpiration)).Delete(&presenceRow{}).Error
+}
@@ -0,0 +1,79 @@
+package realtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/esgi-challenge/backend/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestPresence(t *testing.T) {
+	t.Parallel()
+
+	presence := NewPresence()
+
+	assert.False(t, presence.IsOnline(1))
+
+	assert.True(t, presence.Connect(1))
+	assert.False(t, presence.Connect(1))
+	assert.True(t, presence.Connect(2))
+	assert.True(t, presence.IsOnline(1))
+
+	assert.False(t, presence.Disconnect(1))
+	assert.True(t, presence.IsOnline(1))
+	assert.True(t, presence.Disconnect(1))
+	assert.False(t, presence.IsOnline(1))
+	assert.True(t, presence.IsOnline(2))
+
+	// Unknown user
+	assert.False(t, presence.Disconnect(3))
+}
+
+func TestSharedPresence(t *testing.T) {
+	t.Parallel()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	assert.NoError(t, err)
+
+	// Every connection to an in-memory database opens a new one
+	sqlDB, err := db.DB()
+	assert.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	log := logger.NewLogger()
+	log.InitLogger()
+
+	first, err := newSharedPresence(db, log)
+	assert.NoError(t, err)
+	second, err := newSharedPresence(db, log)
+	assert.NoError(t, err)
+
+	assert.True(t, first.Connect(1))
+	// Already online on the first instance
+	assert.False(t, second.Connect(1))
+	assert.True(t, second.IsOnline(1))
+	assert.Equal(t, map[uint]bool{1: true}, second.OnlineUsers([]uint{1, 2}))
+
+	// Still online on the second instance
+	assert.False(t, first.Disconnect(1))
+	assert.True(t, first.IsOnline(1))
+	assert.True(t, second.Disconnect(1))
+	assert.False(t, first.IsOnline(1))
+
+	// Users of an instance that stopped are offline once their rows expired
+	assert.True(t, second.Connect(2))
+	assert.NoError(t, db.Model(&presenceRow{}).Where("instance = ?", second.instance).Update("seen_at", time.Now().Add(-presenceExpiration-time.Second)).Error)
+	assert.False(t, first.IsOnline(2))
+
+	assert.NoError(t, first.beat())
+	var count int64
+	assert.NoError(t, db.Model(&presenceRow{}).Count(&count).Error)
+	assert.Equal(t, int64(0), count)
+
+	// The heartbeat saves the users of the instance again
+	assert.NoError(t, second.beat())
+	assert.True(t, first.IsOnline(2))
+}
@@ -0,0 +1,40 @@
+package request
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/esgi-challenge/backend/internal/models"
+	"github.com/gin-gonic/gin"
+)
+
+// Period of the "from" and "to" unix timestamps of the query, upcoming events by default
+func ValidateEventPeriod(ctx *gin.Context) (*models.EventPeriod, error) {
+	period := models.EventPeriod{From: time.Now()}
+
+	if fromStr := ctx.Query("from"); fromStr != "" {
+		from, err := strconv.ParseInt(fromStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		period.From = time.Unix(from, 0)
+	}
+
+	if toStr := ctx.Query("to"); toStr != "" {
+		to, err := strconv.ParseInt(toStr, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		toTime := time.Unix(to, 0)
+		period.To = &toTime
+	}
+
+	if period.To != nil && period.To.Before(period.From) {
+		return nil, errors.New("the period ends before it starts")
+	}
+
+	return &period, nil
+}
@@ -0,0 +1,119 @@
+package scanner
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// Size of the chunks sent to clamd, must stay under its StreamMaxLength
+const chunkSize = 64 * 1024
+
+const dialTimeout = 10 * time.Second
+
+type Result struct {
+	Infected  bool
+	Signature string
+}
+
+type Scanner interface {
+	// The file is streamed, it is never fully loaded in memory
+	Scan(ctx context.Context, file io.Reader) (*Result, error)
+}
+
+type clamdScanner struct {
+	address string
+}
+
+// Scanner talking to a clamd compatible daemon over TCP using the INSTREAM command
+func NewClamdScanner(address string) Scanner {
+	return &clamdScanner{address: address}
+}
+
+func (s *clamdScanner) Scan(ctx context.Context, file io.Reader) (*Result, error) {
+	dialer := net.Dialer{Timeout: dialTimeout}
+
+	conn, err := dialer.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	// "z" prefix means the command and the reply are null terminated
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return nil, err
+	}
+
+	chunk := make([]byte, chunkSize)
+
+	for {
+		n, err := io.ReadFull(file, chunk)
+
+		if n > 0 {
+			if err := writeChunk(conn, chunk[:n]); err != nil {
+				return nil, err
+			}
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// A zero length chunk marks the end of the stream
+	if err := writeChunk(conn, nil); err != nil {
+		return nil, err
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString('\x00')
+	if err != nil && !(errors.Is(err, io.EOF) && reply != "") {
+		return nil, err
+	}
+
+	return parseReply(reply)
+}
+
+func writeChunk(w io.Writer, chunk []byte) error {
+	size := make([]byte, 4)
+	binary.BigEndian.PutUint32(size, uint32(len(chunk)))
+
+	if _, err := w.Write(size); err != nil {
+		return err
+	}
+
+	_, err := w.Write(chunk)
+
+	return err
+}
+
+// Replies look like "stream: OK", "stream: <signature> FOUND" or "<message> ERROR"
+func parseReply(reply string) (*Result, error) {
+	reply = strings.TrimRight(reply, "\x00\n")
+	reply = strings.TrimPrefix(reply, "stream: ")
+
+	switch {
+	case reply == "OK":
+		return &Result{Infected: false}, nil
+	case strings.HasSuffix(reply, " FOUND"):
+		return &Result{
+			Infected:  true,
+			Signature: strings.TrimSuffix(reply, " FOUND"),
+		}, nil
+	default:
+		return nil, fmt.Errorf("clamd: %s", reply)
+	}
+}
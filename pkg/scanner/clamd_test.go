@@ -0,0 +1,138 @@
+package scanner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// Minimal clamd answering INSTREAM commands, flags the EICAR test string
+func fakeClamd(t *testing.T, reply func(data []byte) string) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func(conn net.Conn) {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+
+				command, err := reader.ReadString('\x00')
+				if err != nil || command != "zINSTREAM\x00" {
+					conn.Write([]byte("UNKNOWN COMMAND\x00"))
+					return
+				}
+
+				var data []byte
+				for {
+					var size uint32
+					if err := binary.Read(reader, binary.BigEndian, &size); err != nil {
+						return
+					}
+					if size == 0 {
+						break
+					}
+
+					chunk := make([]byte, size)
+					if _, err := io.ReadFull(reader, chunk); err != nil {
+						return
+					}
+					data = append(data, chunk...)
+				}
+
+				conn.Write([]byte(reply(data) + "\x00"))
+			}(conn)
+		}
+	}()
+
+	return listener.Addr().String()
+}
+
+func eicarReply(data []byte) string {
+	if bytes.Contains(data, []byte(eicar)) {
+		return "stream: Eicar-Test-Signature FOUND"
+	}
+
+	return "stream: OK"
+}
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	scanner := NewClamdScanner(fakeClamd(t, eicarReply))
+
+	t.Run("clean file", func(t *testing.T) {
+		result, err := scanner.Scan(context.Background(), strings.NewReader("hello world"))
+
+		assert.NoError(t, err)
+		assert.False(t, result.Infected)
+		assert.Empty(t, result.Signature)
+	})
+
+	t.Run("infected file", func(t *testing.T) {
+		result, err := scanner.Scan(context.Background(), strings.NewReader(eicar))
+
+		assert.NoError(t, err)
+		assert.True(t, result.Infected)
+		assert.Equal(t, "Eicar-Test-Signature", result.Signature)
+	})
+
+	t.Run("file bigger than a chunk", func(t *testing.T) {
+		file := append(bytes.Repeat([]byte("a"), chunkSize*2), []byte(eicar)...)
+
+		result, err := scanner.Scan(context.Background(), bytes.NewReader(file))
+
+		assert.NoError(t, err)
+		assert.True(t, result.Infected)
+	})
+
+	t.Run("empty file", func(t *testing.T) {
+		result, err := scanner.Scan(context.Background(), strings.NewReader(""))
+
+		assert.NoError(t, err)
+		assert.False(t, result.Infected)
+	})
+}
+
+func TestScanError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("daemon error", func(t *testing.T) {
+		scanner := NewClamdScanner(fakeClamd(t, func(data []byte) string {
+			return "INSTREAM size limit exceeded. ERROR"
+		}))
+
+		result, err := scanner.Scan(context.Background(), strings.NewReader("hello world"))
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "clamd: INSTREAM size limit exceeded. ERROR")
+	})
+
+	t.Run("daemon unreachable", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.NoError(t, err)
+		address := listener.Addr().String()
+		listener.Close()
+
+		result, err := NewClamdScanner(address).Scan(context.Background(), strings.NewReader("hello world"))
+
+		assert.Nil(t, result)
+		assert.Error(t, err)
+	})
+}
@@ -2,15 +2,33 @@ package storage
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"path"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/esgi-challenge/backend/config"
 	"github.com/esgi-challenge/backend/pkg/logger"
 	"github.com/google/uuid"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iterator"
 	"gorm.io/gorm"
 )
 
+// Limit of GCS compose requests
+const maxComposeSources = 32
+
+var ErrChunkMismatch = errors.New("a different chunk was already uploaded at this offset")
+
+type StoredFile struct {
+	Name    string
+	Created time.Time
+}
+
 type Storage struct {
 	cfg    *config.Config
 	psqlDB *gorm.DB
@@ -44,14 +62,115 @@ func (s Storage) UploadFile(ctx context.Context, file []byte) (string, error) {
 
 	obj := bkt.Object(filename)
 
+	// Files stay private until they are published, e.g. once scanned
 	w := obj.NewWriter(ctx)
+	_, err := w.Write(file)
+
+	if err != nil {
+		s.logger.Error("GCS Error: %s", err)
+		return "", err
+	}
+
+	if err := w.Close(); err != nil {
+		s.logger.Error("GCS Error: %s", err)
+		return "", err
+	}
+
+	return filename, nil
+}
+
+func (s Storage) PublishFile(ctx context.Context, filename string) error {
+	obj := s.client.Bucket(s.cfg.Bucket).Object(filename)
+
+	if err := obj.ACL().Set(ctx, storage.AllUsers, storage.RoleReader); err != nil {
+		s.logger.Error("GCS Error: %s", err)
+		return err
+	}
+
+	return nil
+}
+
+func (s Storage) ReadFile(ctx context.Context, filename string) ([]byte, error) {
+	r, err := s.OpenFile(ctx, filename)
+
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return io.ReadAll(r)
+}
+
+// Stream the file instead of loading it in memory, the reader must be closed
+func (s Storage) OpenFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	r, err := s.client.Bucket(s.cfg.Bucket).Object(filename).NewReader(ctx)
+
+	if err != nil {
+		s.logger.Error("GCS Error: %s", err)
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Move the file out of the files/ folder so it can't be served anymore, returns the new filename
+func (s Storage) QuarantineFile(ctx context.Context, filename string) (string, error) {
+	bkt := s.client.Bucket(s.cfg.Bucket)
+
+	quarantined := fmt.Sprintf("quarantine/%s", path.Base(filename))
+
+	src := bkt.Object(filename)
+	dst := bkt.Object(quarantined)
+
+	if _, err := dst.CopierFrom(src).Run(ctx); err != nil {
+		s.logger.Error("GCS Error: %s", err)
+		return "", err
+	}
+
+	if err := src.Delete(ctx); err != nil {
+		s.logger.Error("GCS Error: %s", err)
+		return "", err
+	}
+
+	return quarantined, nil
+}
+
+// Previews are generated from scanned files only so they are public right away
+func (s Storage) UploadPreview(ctx context.Context, preview []byte) (string, error) {
+	bkt := s.client.Bucket(s.cfg.Bucket)
+
+	filename := fmt.Sprintf("previews/%s.jpg", uuid.NewString())
+
+	w := bkt.Object(filename).NewWriter(ctx)
+	w.ContentType = "image/jpeg"
 	w.ACL = []storage.ACLRule{{
 		Entity: storage.AllUsers,
 		Role:   storage.RoleReader,
 	}}
-	_, err := w.Write(file)
 
-	if err != nil {
+	if _, err := w.Write(preview); err != nil {
+		s.logger.Error("GCS Error: %s", err)
+		return "", err
+	}
+
+	if err := w.Close(); err != nil {
+		s.logger.Error("GCS Error: %s", err)
+		return "", err
+	}
+
+	return filename, nil
+}
+
+// Chat attachments stay private, they are only served to the members of their channel
+func (s Storage) UploadAttachment(ctx context.Context, file []byte, contentType string) (string, error) {
+	bkt := s.client.Bucket(s.cfg.Bucket)
+
+	filename := fmt.Sprintf("attachments/%s", uuid.NewString())
+
+	w := bkt.Object(filename).NewWriter(ctx)
+	w.ContentType = contentType
+
+	if _, err := w.Write(file); err != nil {
 		s.logger.Error("GCS Error: %s", err)
 		return "", err
 	}
@@ -63,3 +182,117 @@ func (s Storage) UploadFile(ctx context.Context, file []byte) (string, error) {
 
 	return filename, nil
 }
+
+func (s Storage) PublicUrl(filename string) string {
+	return fmt.Sprintf("https://storage.googleapis.com/%s/%s", s.cfg.Bucket, filename)
+}
+
+// Deleting a file that does not exist is not an error
+func (s Storage) DeleteFile(ctx context.Context, filename string) error {
+	err := s.client.Bucket(s.cfg.Bucket).Object(filename).Delete(ctx)
+
+	if err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		s.logger.Error("GCS Error: %s", err)
+		return err
+	}
+
+	return nil
+}
+
+func (s Storage) ListFiles(ctx context.Context, prefix string) ([]StoredFile, error) {
+	var files []StoredFile
+
+	it := s.client.Bucket(s.cfg.Bucket).Objects(ctx, &storage.Query{Prefix: prefix})
+
+	for {
+		attrs, err := it.Next()
+
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			s.logger.Error("GCS Error: %s", err)
+			return nil, err
+		}
+
+		files = append(files, StoredFile{
+			Name:    attrs.Name,
+			Created: attrs.Created,
+		})
+	}
+
+	return files, nil
+}
+
+// Chunks are never overwritten. Writing the same chunk again succeeds so that a request
+// failing after the write can be retried, a different chunk at that offset fails
+func (s Storage) UploadChunk(ctx context.Context, filename string, chunk []byte) error {
+	obj := s.client.Bucket(s.cfg.Bucket).Object(filename)
+
+	w := obj.If(storage.Conditions{DoesNotExist: true}).NewWriter(ctx)
+
+	if _, err := w.Write(chunk); err != nil {
+		s.logger.Error("GCS Error: %s", err)
+		return err
+	}
+
+	err := w.Close()
+
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusPreconditionFailed {
+		attrs, err := obj.Attrs(ctx)
+
+		if err != nil {
+			s.logger.Error("GCS Error: %s", err)
+			return err
+		}
+
+		if attrs.Size != int64(len(chunk)) || attrs.CRC32C != crc32.Checksum(chunk, crc32.MakeTable(crc32.Castagnoli)) {
+			return ErrChunkMismatch
+		}
+
+		return nil
+	}
+
+	if err != nil {
+		s.logger.Error("GCS Error: %s", err)
+		return err
+	}
+
+	return nil
+}
+
+// Concatenate the files in order into a new file of the files/ folder, the sources are kept
+func (s Storage) ComposeFiles(ctx context.Context, sources []string) (string, error) {
+	bkt := s.client.Bucket(s.cfg.Bucket)
+
+	filename := fmt.Sprintf("files/%s", uuid.NewString())
+
+	// GCS composes at most 32 objects at once, the result is used as the first source of the next batch
+	var composed []string
+	remaining := sources
+
+	for {
+		batch := remaining[:min(maxComposeSources-len(composed), len(remaining))]
+		remaining = remaining[len(batch):]
+
+		objects := []*storage.ObjectHandle{}
+		for _, source := range append(composed, batch...) {
+			objects = append(objects, bkt.Object(source))
+		}
+
+		if _, err := bkt.Object(filename).ComposerFrom(objects...).Run(ctx); err != nil {
+			s.logger.Error("GCS Error: %s", err)
+			return "", err
+		}
+
+		composed = []string{filename}
+
+		if len(remaining) == 0 {
+			break
+		}
+	}
+
+	return filename, nil
+}
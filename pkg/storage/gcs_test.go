@@ -2,8 +2,11 @@ package storage
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"path"
 	"testing"
 
 	"cloud.google.com/go/storage"
@@ -22,6 +25,7 @@ func setup() (*Storage, *fakestorage.Server, error) {
 	}
 
 	log := logger.NewLogger()
+	log.InitLogger()
 
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 	if err != nil {
@@ -62,3 +66,171 @@ func TestNewStorage(t *testing.T) {
 	assert.NotNil(t, storage)
 	assert.NotNil(t, storage.client)
 }
+
+func TestQuarantineFile(t *testing.T) {
+	storage, server, err := setup()
+	defer server.Stop()
+	assert.NoError(t, err)
+
+	server.CreateBucketWithOpts(fakestorage.CreateBucketOpts{Name: "test-bucket"})
+	storage.client = server.Client()
+
+	filename, err := storage.UploadFile(context.Background(), []byte("content"))
+	assert.NoError(t, err)
+
+	quarantined, err := storage.QuarantineFile(context.Background(), filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "quarantine/"+path.Base(filename), quarantined)
+
+	_, err = storage.ReadFile(context.Background(), filename)
+	assert.Error(t, err)
+
+	content, err := storage.ReadFile(context.Background(), quarantined)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("content"), content)
+}
+
+func TestOpenFile(t *testing.T) {
+	storage, server, err := setup()
+	defer server.Stop()
+	assert.NoError(t, err)
+
+	server.CreateBucketWithOpts(fakestorage.CreateBucketOpts{Name: "test-bucket"})
+	storage.client = server.Client()
+
+	filename, err := storage.UploadFile(context.Background(), []byte("content"))
+	assert.NoError(t, err)
+
+	r, err := storage.OpenFile(context.Background(), filename)
+	assert.NoError(t, err)
+	defer r.Close()
+
+	content, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("content"), content)
+
+	_, err = storage.OpenFile(context.Background(), "files/missing")
+	assert.Error(t, err)
+}
+
+func TestUploadPreview(t *testing.T) {
+	storage, server, err := setup()
+	defer server.Stop()
+	assert.NoError(t, err)
+
+	server.CreateBucketWithOpts(fakestorage.CreateBucketOpts{Name: "test-bucket"})
+	storage.client = server.Client()
+
+	filename, err := storage.UploadPreview(context.Background(), []byte("preview"))
+	assert.NoError(t, err)
+	assert.Regexp(t, `^previews/.+\.jpg$`, filename)
+
+	object, err := server.GetObject("test-bucket", filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "image/jpeg", object.ContentType)
+	assert.Equal(t, "https://storage.googleapis.com/test-bucket/"+filename, storage.PublicUrl(filename))
+}
+
+func TestUploadAttachment(t *testing.T) {
+	storage, server, err := setup()
+	defer server.Stop()
+	assert.NoError(t, err)
+
+	server.CreateBucketWithOpts(fakestorage.CreateBucketOpts{Name: "test-bucket"})
+	storage.client = server.Client()
+
+	filename, err := storage.UploadAttachment(context.Background(), []byte("attachment"), "text/plain; charset=utf-8")
+	assert.NoError(t, err)
+	assert.Regexp(t, `^attachments/.+$`, filename)
+
+	object, err := server.GetObject("test-bucket", filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "text/plain; charset=utf-8", object.ContentType)
+	assert.Equal(t, []byte("attachment"), object.Content)
+
+	file, err := storage.ReadFile(context.Background(), filename)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("attachment"), file)
+}
+
+func TestDeleteAndListFiles(t *testing.T) {
+	storage, server, err := setup()
+	defer server.Stop()
+	assert.NoError(t, err)
+
+	server.CreateBucketWithOpts(fakestorage.CreateBucketOpts{Name: "test-bucket"})
+	storage.client = server.Client()
+
+	first, err := storage.UploadFile(context.Background(), []byte("first"))
+	assert.NoError(t, err)
+	second, err := storage.UploadFile(context.Background(), []byte("second"))
+	assert.NoError(t, err)
+	_, err = storage.UploadPreview(context.Background(), []byte("preview"))
+	assert.NoError(t, err)
+
+	files, err := storage.ListFiles(context.Background(), "files/")
+	assert.NoError(t, err)
+	assert.Len(t, files, 2)
+
+	assert.NoError(t, storage.DeleteFile(context.Background(), first))
+	assert.NoError(t, storage.DeleteFile(context.Background(), first))
+
+	files, err = storage.ListFiles(context.Background(), "files/")
+	assert.NoError(t, err)
+	assert.Len(t, files, 1)
+	assert.Equal(t, second, files[0].Name)
+}
+
+func TestUploadChunk(t *testing.T) {
+	storage, server, err := setup()
+	defer server.Stop()
+	assert.NoError(t, err)
+
+	server.CreateBucketWithOpts(fakestorage.CreateBucketOpts{Name: "test-bucket"})
+	storage.client = server.Client()
+
+	assert.NoError(t, storage.UploadChunk(context.Background(), "uploads/1/0", []byte("chunk")))
+	// Retried chunk
+	assert.NoError(t, storage.UploadChunk(context.Background(), "uploads/1/0", []byte("chunk")))
+	assert.ErrorIs(t, storage.UploadChunk(context.Background(), "uploads/1/0", []byte("other")), ErrChunkMismatch)
+	assert.ErrorIs(t, storage.UploadChunk(context.Background(), "uploads/1/0", []byte("longer chunk")), ErrChunkMismatch)
+
+	content, err := storage.ReadFile(context.Background(), "uploads/1/0")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("chunk"), content)
+}
+
+func TestComposeFiles(t *testing.T) {
+	storage, server, err := setup()
+	defer server.Stop()
+	assert.NoError(t, err)
+
+	server.CreateBucketWithOpts(fakestorage.CreateBucketOpts{Name: "test-bucket"})
+	storage.client = server.Client()
+
+	var sources []string
+	var expected []byte
+
+	// More chunks than a single compose request accepts
+	for i := 0; i < 70; i++ {
+		source := fmt.Sprintf("uploads/1/%03d", i)
+		chunk := []byte(fmt.Sprintf("chunk %d;", i))
+
+		assert.NoError(t, storage.UploadChunk(context.Background(), source, chunk))
+		sources = append(sources, source)
+		expected = append(expected, chunk...)
+	}
+
+	filename, err := storage.ComposeFiles(context.Background(), sources)
+	assert.NoError(t, err)
+	assert.Equal(t, "files", path.Dir(filename))
+
+	content, err := storage.ReadFile(context.Background(), filename)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, content)
+
+	// Deleted by the caller once the document is saved
+	files, err := storage.ListFiles(context.Background(), "uploads/")
+	assert.NoError(t, err)
+	assert.Len(t, files, len(sources))
+}